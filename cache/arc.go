@@ -0,0 +1,300 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abhishekR-tech/collections/linear"
+)
+
+// arcList identifies one of the four lists maintained by an ARCCache
+type arcList int
+
+const (
+	// arcT1 holds resident entries seen once recently
+	arcT1 arcList = iota
+	// arcT2 holds resident entries seen at least twice recently
+	arcT2
+	// arcB1 holds ghost keys recently evicted from T1
+	arcB1
+	// arcB2 holds ghost keys recently evicted from T2
+	arcB2
+)
+
+// arcLocation records which list a key is in and its node there
+type arcLocation[K comparable, V any] struct {
+	list arcList
+	node *linear.Node[entry[K, V]]
+}
+
+// ARCCache represents a fixed-capacity Adaptive Replacement Cache.
+// It balances recency (T1) against frequency (T2) and uses the ghost
+// lists B1 and B2 of recently evicted keys to adapt the split between them,
+// which keeps one-off scans from flushing frequently used entries.
+type ARCCache[K comparable, V any] struct {
+	capacity int
+	target   int
+	lists    [4]*linear.LinkedList[entry[K, V]]
+	items    map[K]arcLocation[K, V]
+	onEvict  func(key K, value V)
+	stats    Stats
+}
+
+// NewARCCache creates a new ARC cache holding at most capacity entries.
+// onEvict, if not nil, is called for every entry evicted to make room.
+// It panics if capacity is not positive.
+func NewARCCache[K comparable, V any](capacity int, onEvict func(key K, value V)) *ARCCache[K, V] {
+	if capacity <= 0 {
+		panic("cache: capacity must be positive")
+	}
+	c := &ARCCache[K, V]{
+		capacity: capacity,
+		items:    make(map[K]arcLocation[K, V]),
+		onEvict:  onEvict,
+	}
+	for i := range c.lists {
+		c.lists[i] = linear.NewLinkedList[entry[K, V]]()
+	}
+	return c
+}
+
+// Get returns the value for key and promotes it to the frequently used list
+func (c *ARCCache[K, V]) Get(key K) (V, bool) {
+	loc, ok := c.items[key]
+	if !ok || loc.list == arcB1 || loc.list == arcB2 {
+		c.stats.Misses++
+		return *new(V), false
+	}
+	c.stats.Hits++
+	c.moveToFront(key, loc, arcT2)
+	return loc.node.Value.value, true
+}
+
+// Peek returns the value for key without affecting the eviction policy
+func (c *ARCCache[K, V]) Peek(key K) (V, bool) {
+	loc, ok := c.items[key]
+	if !ok || loc.list == arcB1 || loc.list == arcB2 {
+		return *new(V), false
+	}
+	return loc.node.Value.value, true
+}
+
+// Contains returns true if key is cached, without affecting the eviction policy
+func (c *ARCCache[K, V]) Contains(key K) bool {
+	loc, ok := c.items[key]
+	return ok && (loc.list == arcT1 || loc.list == arcT2)
+}
+
+// Put inserts or updates key.
+// It returns true if an entry was evicted to make room.
+func (c *ARCCache[K, V]) Put(key K, value V) bool {
+	loc, ok := c.items[key]
+	if !ok {
+		return c.putNew(key, value)
+	}
+
+	switch loc.list {
+	case arcT1, arcT2:
+		loc.node.Value.value = value
+		c.moveToFront(key, loc, arcT2)
+		return false
+
+	case arcB1:
+		// A ghost hit in B1 means T1 was too small
+		delta := max(c.lists[arcB2].Size()/c.lists[arcB1].Size(), 1)
+		c.target = min(c.target+delta, c.capacity)
+
+	case arcB2:
+		// A ghost hit in B2 means T2 was too small
+		delta := max(c.lists[arcB1].Size()/c.lists[arcB2].Size(), 1)
+		c.target = max(c.target-delta, 0)
+	}
+
+	evicted := false
+	if c.Len() >= c.capacity {
+		c.replace(loc.list == arcB2)
+		evicted = true
+	}
+	loc.node.Value.value = value
+	c.moveToFront(key, loc, arcT2)
+	return evicted
+}
+
+// Remove deletes key from the cache and returns true if it was present.
+// Any ghost entry for key is forgotten as well.
+// The eviction callback is not called for explicit removals.
+func (c *ARCCache[K, V]) Remove(key K) bool {
+	loc, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.lists[loc.list].Remove(loc.node)
+	delete(c.items, key)
+	return loc.list == arcT1 || loc.list == arcT2
+}
+
+// Len returns the number of cached entries, excluding ghost keys
+func (c *ARCCache[K, V]) Len() int {
+	return c.lists[arcT1].Size() + c.lists[arcT2].Size()
+}
+
+// Capacity returns the maximum number of entries the cache holds
+func (c *ARCCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Resize changes the capacity, evicting entries and trimming ghost keys if needed.
+// It returns the number of entries evicted and panics if capacity is not positive.
+func (c *ARCCache[K, V]) Resize(capacity int) int {
+	if capacity <= 0 {
+		panic("cache: capacity must be positive")
+	}
+	c.capacity = capacity
+	c.target = min(c.target, capacity)
+
+	evicted := 0
+	for c.Len() > c.capacity {
+		c.replace(false)
+		evicted++
+	}
+	for c.lists[arcB1].Size() > 0 && c.lists[arcT1].Size()+c.lists[arcB1].Size() > c.capacity {
+		c.dropGhost(arcB1)
+	}
+	for c.lists[arcB2].Size() > 0 && len(c.items) > 2*c.capacity {
+		c.dropGhost(arcB2)
+	}
+	return evicted
+}
+
+// Target returns the adaptive target size of the recency list T1
+func (c *ARCCache[K, V]) Target() int {
+	return c.target
+}
+
+// Stats returns the hit, miss and eviction counters
+func (c *ARCCache[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// ResetStats sets all counters back to zero
+func (c *ARCCache[K, V]) ResetStats() {
+	c.stats = Stats{}
+}
+
+// Clear removes all entries and ghost keys without calling the eviction callback
+func (c *ARCCache[K, V]) Clear() {
+	c.target = 0
+	c.items = make(map[K]arcLocation[K, V])
+	for _, list := range c.lists {
+		list.Clear()
+	}
+}
+
+// String returns a string representation of the cached entries, T1 then T2,
+// each from most to least recently used
+func (c *ARCCache[K, V]) String() string {
+	if c.Len() == 0 {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for _, list := range []arcList{arcT1, arcT2} {
+		for node := c.lists[list].Front(); node != nil; node = node.Next {
+			if !first {
+				sb.WriteString(" ")
+			}
+			first = false
+			sb.WriteString(fmt.Sprintf("%v:%v", node.Value.key, node.Value.value))
+		}
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// putNew inserts a key that is in neither the cache nor the ghost lists
+func (c *ARCCache[K, V]) putNew(key K, value V) bool {
+	evicted := false
+	t1, b1 := c.lists[arcT1], c.lists[arcB1]
+
+	if t1.Size()+b1.Size() >= c.capacity {
+		if t1.Size() < c.capacity {
+			c.dropGhost(arcB1)
+			if c.Len() >= c.capacity {
+				c.replace(false)
+				evicted = true
+			}
+		} else {
+			// B1 is empty and T1 fills the cache, so discard T1's oldest outright
+			c.evictFrom(arcT1, false)
+			evicted = true
+		}
+	} else if len(c.items) >= c.capacity {
+		if len(c.items) >= 2*c.capacity {
+			c.dropGhost(arcB2)
+		}
+		if c.Len() >= c.capacity {
+			c.replace(false)
+			evicted = true
+		}
+	}
+
+	node := t1.PushFront(entry[K, V]{key: key, value: value})
+	c.items[key] = arcLocation[K, V]{list: arcT1, node: node}
+	return evicted
+}
+
+// replace evicts one resident entry into the matching ghost list,
+// choosing T1 or T2 according to the adaptive target
+func (c *ARCCache[K, V]) replace(inB2 bool) {
+	t1Size := c.lists[arcT1].Size()
+	if t1Size > 0 && (t1Size > c.target || (inB2 && t1Size == c.target) || c.lists[arcT2].IsEmpty()) {
+		c.evictFrom(arcT1, true)
+	} else {
+		c.evictFrom(arcT2, true)
+	}
+}
+
+// evictFrom removes the least recently used entry of a resident list,
+// remembering its key in the matching ghost list if ghost is true
+func (c *ARCCache[K, V]) evictFrom(list arcList, ghost bool) {
+	victim := c.lists[list].Remove(c.lists[list].Back())
+	if ghost {
+		ghostList := arcB1
+		if list == arcT2 {
+			ghostList = arcB2
+		}
+		node := c.lists[ghostList].PushFront(entry[K, V]{key: victim.key})
+		c.items[victim.key] = arcLocation[K, V]{list: ghostList, node: node}
+	} else {
+		delete(c.items, victim.key)
+	}
+
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(victim.key, victim.value)
+	}
+}
+
+// dropGhost forgets the oldest key of a ghost list
+func (c *ARCCache[K, V]) dropGhost(list arcList) {
+	if c.lists[list].IsEmpty() {
+		return
+	}
+	victim := c.lists[list].Remove(c.lists[list].Back())
+	delete(c.items, victim.key)
+}
+
+// moveToFront moves key from its current list to the front of list
+func (c *ARCCache[K, V]) moveToFront(key K, loc arcLocation[K, V], list arcList) {
+	if loc.list == list {
+		c.lists[list].MoveToFront(loc.node)
+		return
+	}
+	c.lists[loc.list].Remove(loc.node)
+	c.lists[list].PushFront(loc.node.Value)
+	c.items[key] = arcLocation[K, V]{list: list, node: c.lists[list].Front()}
+}
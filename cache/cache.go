@@ -0,0 +1,60 @@
+package cache
+
+// Cache represents a fixed-capacity key/value cache with an eviction policy
+type Cache[K comparable, V any] interface {
+	// Get returns the value for key, counting a hit or a miss
+	Get(key K) (V, bool)
+
+	// Peek returns the value for key without affecting the eviction policy or the stats
+	Peek(key K) (V, bool)
+
+	// Contains returns true if key is cached, without affecting the eviction policy or the stats
+	Contains(key K) bool
+
+	// Put inserts or updates key and returns true if an entry was evicted to make room
+	Put(key K, value V) bool
+
+	// Remove deletes key from the cache and returns true if it was present
+	Remove(key K) bool
+
+	// Len returns the number of cached entries
+	Len() int
+
+	// Capacity returns the maximum number of cached entries
+	Capacity() int
+
+	// Resize changes the capacity and returns the number of entries evicted
+	Resize(capacity int) int
+
+	// Clear removes all entries without calling the eviction callback
+	Clear()
+
+	// Stats returns the hit, miss and eviction counters
+	Stats() Stats
+
+	// ResetStats sets all counters back to zero
+	ResetStats()
+}
+
+// Stats holds the counters used to compare cache policies
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRatio returns the fraction of Get calls that were hits, or 0 if there were none
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+var (
+	_ Cache[int, int] = (*LRUCache[int, int])(nil)
+	_ Cache[int, int] = (*SyncLRUCache[int, int])(nil)
+	_ Cache[int, int] = (*LFUCache[int, int])(nil)
+	_ Cache[int, int] = (*ARCCache[int, int])(nil)
+)
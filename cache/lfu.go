@@ -0,0 +1,228 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/abhishekR-tech/collections/linear"
+)
+
+// lfuBucket groups all entries that have been used the same number of times
+type lfuBucket[K comparable, V any] struct {
+	freq    int
+	entries *linear.LinkedList[*lfuEntry[K, V]]
+}
+
+// lfuEntry is a cached key/value pair together with its position in the buckets
+type lfuEntry[K comparable, V any] struct {
+	key    K
+	value  V
+	bucket *linear.Node[*lfuBucket[K, V]]
+	node   *linear.Node[*lfuEntry[K, V]]
+}
+
+// LFUCache represents a fixed-capacity cache that evicts the least frequently used entry.
+// Ties are broken by evicting the least recently used of the candidates.
+// All operations are O(1): entries live in per-frequency linked lists, and the
+// buckets themselves are kept in a linked list sorted by frequency.
+type LFUCache[K comparable, V any] struct {
+	capacity int
+	items    map[K]*lfuEntry[K, V]
+	buckets  *linear.LinkedList[*lfuBucket[K, V]]
+	onEvict  func(key K, value V)
+	stats    Stats
+}
+
+// NewLFUCache creates a new LFU cache holding at most capacity entries.
+// onEvict, if not nil, is called for every entry evicted to make room.
+// It panics if capacity is not positive.
+func NewLFUCache[K comparable, V any](capacity int, onEvict func(key K, value V)) *LFUCache[K, V] {
+	if capacity <= 0 {
+		panic("cache: capacity must be positive")
+	}
+	return &LFUCache[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*lfuEntry[K, V]),
+		buckets:  linear.NewLinkedList[*lfuBucket[K, V]](),
+		onEvict:  onEvict,
+	}
+}
+
+// Get returns the value for key and increments its use count
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return *new(V), false
+	}
+	c.stats.Hits++
+	c.touch(e)
+	return e.value, true
+}
+
+// Peek returns the value for key without changing its use count
+func (c *LFUCache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return *new(V), false
+	}
+	return e.value, true
+}
+
+// Contains returns true if key is in the cache, without changing its use count
+func (c *LFUCache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Put inserts or updates key and increments its use count.
+// It returns true if an entry was evicted to make room.
+func (c *LFUCache[K, V]) Put(key K, value V) bool {
+	if e, ok := c.items[key]; ok {
+		e.value = value
+		c.touch(e)
+		return false
+	}
+
+	evicted := false
+	if len(c.items) >= c.capacity {
+		c.evict()
+		evicted = true
+	}
+
+	front := c.buckets.Front()
+	if front == nil || front.Value.freq != 1 {
+		front = c.buckets.PushFront(&lfuBucket[K, V]{
+			freq:    1,
+			entries: linear.NewLinkedList[*lfuEntry[K, V]](),
+		})
+	}
+
+	e := &lfuEntry[K, V]{key: key, value: value, bucket: front}
+	e.node = front.Value.entries.PushFront(e)
+	c.items[key] = e
+	return evicted
+}
+
+// Remove deletes key from the cache and returns true if it was present.
+// The eviction callback is not called for explicit removals.
+func (c *LFUCache[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.detach(e)
+	delete(c.items, key)
+	return true
+}
+
+// Frequency returns how many times key has been used, or 0 if it is not cached
+func (c *LFUCache[K, V]) Frequency(key K) int {
+	e, ok := c.items[key]
+	if !ok {
+		return 0
+	}
+	return e.bucket.Value.freq
+}
+
+// Len returns the number of entries in the cache
+func (c *LFUCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Capacity returns the maximum number of entries the cache holds
+func (c *LFUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Resize changes the capacity, evicting the least frequently used entries if needed.
+// It returns the number of entries evicted and panics if capacity is not positive.
+func (c *LFUCache[K, V]) Resize(capacity int) int {
+	if capacity <= 0 {
+		panic("cache: capacity must be positive")
+	}
+	c.capacity = capacity
+
+	evicted := 0
+	for len(c.items) > c.capacity {
+		c.evict()
+		evicted++
+	}
+	return evicted
+}
+
+// Stats returns the hit, miss and eviction counters
+func (c *LFUCache[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// ResetStats sets all counters back to zero
+func (c *LFUCache[K, V]) ResetStats() {
+	c.stats = Stats{}
+}
+
+// Clear removes all entries without calling the eviction callback
+func (c *LFUCache[K, V]) Clear() {
+	c.items = make(map[K]*lfuEntry[K, V])
+	c.buckets.Clear()
+}
+
+// String returns a string representation of the cache from least to most frequently used
+func (c *LFUCache[K, V]) String() string {
+	if len(c.items) == 0 {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for bucket := c.buckets.Front(); bucket != nil; bucket = bucket.Next {
+		for node := bucket.Value.entries.Back(); node != nil; node = node.Prev {
+			if !first {
+				sb.WriteString(" ")
+			}
+			first = false
+			sb.WriteString(fmt.Sprintf("%v:%v", node.Value.key, node.Value.value))
+		}
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// touch moves an entry into the bucket for its next frequency
+func (c *LFUCache[K, V]) touch(e *lfuEntry[K, V]) {
+	current := e.bucket
+	next := current.Next
+	if next == nil || next.Value.freq != current.Value.freq+1 {
+		next = c.buckets.InsertAfter(&lfuBucket[K, V]{
+			freq:    current.Value.freq + 1,
+			entries: linear.NewLinkedList[*lfuEntry[K, V]](),
+		}, current)
+	}
+
+	c.detach(e)
+	e.bucket = next
+	e.node = next.Value.entries.PushFront(e)
+}
+
+// detach unlinks an entry from its bucket, dropping the bucket once it is empty
+func (c *LFUCache[K, V]) detach(e *lfuEntry[K, V]) {
+	bucket := e.bucket
+	bucket.Value.entries.Remove(e.node)
+	if bucket.Value.entries.IsEmpty() {
+		c.buckets.Remove(bucket)
+	}
+}
+
+// evict removes the least recently used entry of the lowest frequency
+func (c *LFUCache[K, V]) evict() {
+	victim := c.buckets.Front().Value.entries.Back().Value
+	c.detach(victim)
+	delete(c.items, victim.key)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(victim.key, victim.value)
+	}
+}
@@ -0,0 +1,282 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/abhishekR-tech/collections/linear"
+)
+
+// entry is a key/value pair stored in a cache's recency list
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// LRUCache represents a fixed-capacity cache that evicts the least recently used entry.
+// Recency is tracked with a linear.LinkedList, most recently used at the front.
+type LRUCache[K comparable, V any] struct {
+	capacity int
+	items    map[K]*linear.Node[entry[K, V]]
+	order    *linear.LinkedList[entry[K, V]]
+	onEvict  func(key K, value V)
+	stats    Stats
+}
+
+// NewLRUCache creates a new LRU cache holding at most capacity entries.
+// onEvict, if not nil, is called for every entry evicted to make room.
+// It panics if capacity is not positive.
+func NewLRUCache[K comparable, V any](capacity int, onEvict func(key K, value V)) *LRUCache[K, V] {
+	if capacity <= 0 {
+		panic("cache: capacity must be positive")
+	}
+	return &LRUCache[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*linear.Node[entry[K, V]]),
+		order:    linear.NewLinkedList[entry[K, V]](),
+		onEvict:  onEvict,
+	}
+}
+
+// Get returns the value for key and marks it as most recently used
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	node, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return *new(V), false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(node)
+	return node.Value.value, true
+}
+
+// Peek returns the value for key without updating its recency
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	node, ok := c.items[key]
+	if !ok {
+		return *new(V), false
+	}
+	return node.Value.value, true
+}
+
+// Contains returns true if key is in the cache, without updating its recency
+func (c *LRUCache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Put inserts or updates key and marks it as most recently used.
+// It returns true if an entry was evicted to make room.
+func (c *LRUCache[K, V]) Put(key K, value V) bool {
+	if node, ok := c.items[key]; ok {
+		node.Value.value = value
+		c.order.MoveToFront(node)
+		return false
+	}
+
+	c.items[key] = c.order.PushFront(entry[K, V]{key: key, value: value})
+	if c.order.Size() > c.capacity {
+		c.evictOldest()
+		return true
+	}
+	return false
+}
+
+// Remove deletes key from the cache and returns true if it was present.
+// The eviction callback is not called for explicit removals.
+func (c *LRUCache[K, V]) Remove(key K) bool {
+	node, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.order.Remove(node)
+	delete(c.items, key)
+	return true
+}
+
+// Len returns the number of entries in the cache
+func (c *LRUCache[K, V]) Len() int {
+	return c.order.Size()
+}
+
+// Capacity returns the maximum number of entries the cache holds
+func (c *LRUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Resize changes the capacity, evicting the least recently used entries if needed.
+// It returns the number of entries evicted and panics if capacity is not positive.
+func (c *LRUCache[K, V]) Resize(capacity int) int {
+	if capacity <= 0 {
+		panic("cache: capacity must be positive")
+	}
+	c.capacity = capacity
+
+	evicted := 0
+	for c.order.Size() > c.capacity {
+		c.evictOldest()
+		evicted++
+	}
+	return evicted
+}
+
+// Stats returns the hit, miss and eviction counters
+func (c *LRUCache[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// ResetStats sets all counters back to zero
+func (c *LRUCache[K, V]) ResetStats() {
+	c.stats = Stats{}
+}
+
+// Keys returns the keys from most to least recently used
+func (c *LRUCache[K, V]) Keys() []K {
+	keys := make([]K, 0, c.order.Size())
+	for node := c.order.Front(); node != nil; node = node.Next {
+		keys = append(keys, node.Value.key)
+	}
+	return keys
+}
+
+// Clear removes all entries without calling the eviction callback
+func (c *LRUCache[K, V]) Clear() {
+	c.items = make(map[K]*linear.Node[entry[K, V]])
+	c.order.Clear()
+}
+
+// String returns a string representation of the cache from most to least recently used
+func (c *LRUCache[K, V]) String() string {
+	if c.order.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	for node := c.order.Front(); node != nil; node = node.Next {
+		sb.WriteString(fmt.Sprintf("%v:%v", node.Value.key, node.Value.value))
+		if node.Next != nil {
+			sb.WriteString(" ")
+		}
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// evictOldest removes the least recently used entry and reports it to onEvict
+func (c *LRUCache[K, V]) evictOldest() {
+	oldest := c.order.Remove(c.order.Back())
+	delete(c.items, oldest.key)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(oldest.key, oldest.value)
+	}
+}
+
+// SyncLRUCache is an LRUCache that is safe for concurrent use.
+// The eviction callback runs while the cache lock is held.
+type SyncLRUCache[K comparable, V any] struct {
+	mu  sync.Mutex
+	lru *LRUCache[K, V]
+}
+
+// NewSyncLRUCache creates a new thread-safe LRU cache holding at most capacity entries
+func NewSyncLRUCache[K comparable, V any](capacity int, onEvict func(key K, value V)) *SyncLRUCache[K, V] {
+	return &SyncLRUCache[K, V]{
+		lru: NewLRUCache(capacity, onEvict),
+	}
+}
+
+// Get returns the value for key and marks it as most recently used
+func (c *SyncLRUCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Get(key)
+}
+
+// Peek returns the value for key without updating its recency
+func (c *SyncLRUCache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Peek(key)
+}
+
+// Contains returns true if key is in the cache, without updating its recency
+func (c *SyncLRUCache[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Contains(key)
+}
+
+// Put inserts or updates key and returns true if an entry was evicted
+func (c *SyncLRUCache[K, V]) Put(key K, value V) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Put(key, value)
+}
+
+// Remove deletes key from the cache and returns true if it was present
+func (c *SyncLRUCache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Remove(key)
+}
+
+// Len returns the number of entries in the cache
+func (c *SyncLRUCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// Capacity returns the maximum number of entries the cache holds
+func (c *SyncLRUCache[K, V]) Capacity() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Capacity()
+}
+
+// Resize changes the capacity and returns the number of entries evicted
+func (c *SyncLRUCache[K, V]) Resize(capacity int) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Resize(capacity)
+}
+
+// Stats returns the hit, miss and eviction counters
+func (c *SyncLRUCache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Stats()
+}
+
+// ResetStats sets all counters back to zero
+func (c *SyncLRUCache[K, V]) ResetStats() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lru.ResetStats()
+}
+
+// Keys returns the keys from most to least recently used
+func (c *SyncLRUCache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Keys()
+}
+
+// Clear removes all entries without calling the eviction callback
+func (c *SyncLRUCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lru.Clear()
+}
+
+// String returns a string representation of the cache from most to least recently used
+func (c *SyncLRUCache[K, V]) String() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.String()
+}
@@ -0,0 +1,286 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/abhishekR-tech/collections/linear"
+)
+
+// CostEdge is the edge weight used by MinCostMaxFlow: a capacity and a cost per unit of flow
+type CostEdge[W Number] struct {
+	Capacity W
+	Cost     W
+}
+
+// arc is one direction of an edge in a residual network.
+// Arcs are stored in pairs so the reverse of arc i is arc i^1.
+type arc[W Number] struct {
+	to       int
+	capacity W
+	flow     W
+	cost     W
+}
+
+// residual returns the capacity left on a
+func (a *arc[W]) residual() W {
+	return a.capacity - a.flow
+}
+
+// Flow holds the result of a maximum flow computation
+type Flow[V comparable, W Number] struct {
+	// Value is the total flow from the source to the sink
+	Value W
+	// Cost is the total cost of the flow; it is zero for MaxFlow
+	Cost W
+
+	source   int
+	ids      map[V]int
+	vertices []V
+	arcs     []arc[W]
+	adj      [][]int
+}
+
+// EdgeFlow returns the flow sent along the edge from u to v
+func (f *Flow[V, W]) EdgeFlow(u, v V) W {
+	var total W
+	iu, ok := f.ids[u]
+	if !ok {
+		return total
+	}
+	for _, i := range f.adj[iu] {
+		a := &f.arcs[i]
+		if i%2 == 0 && f.vertices[a.to] == v {
+			total += a.flow
+		}
+	}
+	return total
+}
+
+// Edges returns every edge carrying positive flow, weighted by that flow
+func (f *Flow[V, W]) Edges() []Edge[V, W] {
+	var edges []Edge[V, W]
+	for i := 0; i < len(f.arcs); i += 2 {
+		a := &f.arcs[i]
+		if a.flow > 0 {
+			from := f.vertices[f.arcs[i+1].to]
+			edges = append(edges, Edge[V, W]{From: from, To: f.vertices[a.to], Weight: a.flow})
+		}
+	}
+	return edges
+}
+
+// MinCut returns the vertices on the source side of a minimum cut and the
+// saturated edges crossing it, weighted by capacity. For a maximum flow
+// the crossing capacities sum to Value.
+func (f *Flow[V, W]) MinCut() ([]V, []Edge[V, W]) {
+	reached := f.reachable()
+	var side []V
+	var cut []Edge[V, W]
+	for u, ok := range reached {
+		if !ok {
+			continue
+		}
+		side = append(side, f.vertices[u])
+		for _, i := range f.adj[u] {
+			a := &f.arcs[i]
+			if i%2 == 0 && !reached[a.to] {
+				cut = append(cut, Edge[V, W]{From: f.vertices[u], To: f.vertices[a.to], Weight: a.capacity})
+			}
+		}
+	}
+	return side, cut
+}
+
+// MaxFlow computes a maximum flow from source to sink using Dinic's algorithm,
+// treating edge weights as capacities. Undirected edges carry flow either way.
+// It returns an error if source or sink is missing, they are equal, or a capacity is negative.
+func MaxFlow[V comparable, W Number](g *Graph[V, W], source, sink V) (*Flow[V, W], error) {
+	f, t, err := newFlow(g, source, sink, func(w W) CostEdge[W] {
+		return CostEdge[W]{Capacity: w}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	level := make([]int, len(f.vertices))
+	next := make([]int, len(f.vertices))
+	for f.levels(level, t) {
+		clear(next)
+		var limit W
+		for _, i := range f.adj[f.source] {
+			limit += f.arcs[i].residual()
+		}
+		for {
+			pushed := f.augment(f.source, t, limit, level, next)
+			if pushed <= 0 {
+				break
+			}
+			f.Value += pushed
+		}
+	}
+	return f, nil
+}
+
+// MinCostMaxFlow computes a maximum flow from source to sink with the least total cost,
+// by repeatedly augmenting along the cheapest residual path.
+// Costs may be negative as long as no cycle has negative total cost.
+// It returns an error if source or sink is missing, they are equal, or a capacity is negative.
+func MinCostMaxFlow[V comparable, W Number](g *Graph[V, CostEdge[W]], source, sink V) (*Flow[V, W], error) {
+	f, t, err := newFlow(g, source, sink, func(e CostEdge[W]) CostEdge[W] {
+		return e
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(f.vertices)
+	dist := make([]W, n)
+	reached := make([]bool, n)
+	inQueue := make([]bool, n)
+	prevArc := make([]int, n)
+	for {
+		// Find the cheapest augmenting path with a queue-based Bellman-Ford
+		clear(reached)
+		reached[f.source] = true
+		dist[f.source] = 0
+		queue := linear.NewQueue[int]()
+		queue.Enqueue(f.source)
+		for !queue.IsEmpty() {
+			u, _ := queue.Dequeue()
+			inQueue[u] = false
+			for _, i := range f.adj[u] {
+				a := &f.arcs[i]
+				if a.residual() <= 0 {
+					continue
+				}
+				if d := dist[u] + a.cost; !reached[a.to] || d < dist[a.to] {
+					reached[a.to] = true
+					dist[a.to] = d
+					prevArc[a.to] = i
+					if !inQueue[a.to] {
+						inQueue[a.to] = true
+						queue.Enqueue(a.to)
+					}
+				}
+			}
+		}
+		if !reached[t] {
+			return f, nil
+		}
+
+		bottleneck := f.arcs[prevArc[t]].residual()
+		for v := t; v != f.source; v = f.arcs[prevArc[v]^1].to {
+			bottleneck = min(bottleneck, f.arcs[prevArc[v]].residual())
+		}
+		for v := t; v != f.source; v = f.arcs[prevArc[v]^1].to {
+			f.arcs[prevArc[v]].flow += bottleneck
+			f.arcs[prevArc[v]^1].flow -= bottleneck
+		}
+		f.Value += bottleneck
+		f.Cost += bottleneck * dist[t]
+	}
+}
+
+// newFlow builds the residual network of g and returns it with the sink's index
+func newFlow[V comparable, W Number, E any](g *Graph[V, E], source, sink V, edge func(E) CostEdge[W]) (*Flow[V, W], int, error) {
+	if !g.HasVertex(source) || !g.HasVertex(sink) {
+		return nil, 0, errors.New("source or sink not in graph")
+	}
+	if source == sink {
+		return nil, 0, errors.New("source and sink must differ")
+	}
+
+	f := &Flow[V, W]{
+		ids:      make(map[V]int, g.VertexCount()),
+		vertices: make([]V, 0, g.VertexCount()),
+		adj:      make([][]int, g.VertexCount()),
+	}
+	for v := range g.Vertices() {
+		f.ids[v] = len(f.vertices)
+		f.vertices = append(f.vertices, v)
+	}
+	addArc := func(u, v int, e CostEdge[W]) {
+		i := len(f.arcs)
+		f.arcs = append(f.arcs,
+			arc[W]{to: v, capacity: e.Capacity, cost: e.Cost},
+			arc[W]{to: u, cost: -e.Cost},
+		)
+		f.adj[u] = append(f.adj[u], i)
+		f.adj[v] = append(f.adj[v], i+1)
+	}
+
+	for u := range g.Vertices() {
+		for v, w := range g.Neighbors(u) {
+			e := edge(w)
+			if e.Capacity < 0 {
+				return nil, 0, errors.New("negative capacity")
+			}
+			// An undirected edge is listed from both ends, giving one arc each way
+			addArc(f.ids[u], f.ids[v], e)
+		}
+	}
+	f.source = f.ids[source]
+	return f, f.ids[sink], nil
+}
+
+// levels labels vertices by breadth-first distance from the source in the
+// residual network and returns true if the sink is reachable
+func (f *Flow[V, W]) levels(level []int, sink int) bool {
+	for i := range level {
+		level[i] = -1
+	}
+	level[f.source] = 0
+	queue := linear.NewQueue[int]()
+	queue.Enqueue(f.source)
+	for !queue.IsEmpty() {
+		u, _ := queue.Dequeue()
+		for _, i := range f.adj[u] {
+			a := &f.arcs[i]
+			if a.residual() > 0 && level[a.to] < 0 {
+				level[a.to] = level[u] + 1
+				queue.Enqueue(a.to)
+			}
+		}
+	}
+	return level[sink] >= 0
+}
+
+// augment pushes up to limit units from u towards the sink along level-increasing arcs.
+// next remembers the first arc of each vertex not yet known to be blocked.
+func (f *Flow[V, W]) augment(u, sink int, limit W, level, next []int) W {
+	if u == sink {
+		return limit
+	}
+	for ; next[u] < len(f.adj[u]); next[u]++ {
+		i := f.adj[u][next[u]]
+		a := &f.arcs[i]
+		if a.residual() <= 0 || level[a.to] != level[u]+1 {
+			continue
+		}
+		if pushed := f.augment(a.to, sink, min(limit, a.residual()), level, next); pushed > 0 {
+			f.arcs[i].flow += pushed
+			f.arcs[i^1].flow -= pushed
+			return pushed
+		}
+	}
+	return 0
+}
+
+// reachable marks the vertices reachable from the source in the residual network
+func (f *Flow[V, W]) reachable() []bool {
+	reached := make([]bool, len(f.vertices))
+	reached[f.source] = true
+	stack := linear.NewStack[int]()
+	stack.Push(f.source)
+	for !stack.IsEmpty() {
+		u, _ := stack.Pop()
+		for _, i := range f.adj[u] {
+			a := &f.arcs[i]
+			if a.residual() > 0 && !reached[a.to] {
+				reached[a.to] = true
+				stack.Push(a.to)
+			}
+		}
+	}
+	return reached
+}
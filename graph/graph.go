@@ -0,0 +1,252 @@
+package graph
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/abhishekR-tech/collections/ordered"
+)
+
+// adjacency maps each neighbor of a vertex to the weight of the connecting edge
+type adjacency[V comparable, W any] = ordered.OrderedMap[V, W]
+
+// Edge represents a weighted edge from one vertex to another
+type Edge[V comparable, W any] struct {
+	From   V
+	To     V
+	Weight W
+}
+
+// Graph represents a directed or undirected weighted graph stored as adjacency lists.
+// Vertices and neighbors iterate in insertion order, so traversals are deterministic.
+// An undirected edge is stored in both directions and counted once.
+type Graph[V comparable, W any] struct {
+	directed bool
+	out      *ordered.OrderedMap[V, *adjacency[V, W]]
+	in       *ordered.OrderedMap[V, *adjacency[V, W]]
+	edges    int
+}
+
+// NewDirectedGraph creates a new empty directed graph
+func NewDirectedGraph[V comparable, W any]() *Graph[V, W] {
+	return &Graph[V, W]{
+		directed: true,
+		out:      ordered.NewOrderedMap[V, *adjacency[V, W]](),
+		in:       ordered.NewOrderedMap[V, *adjacency[V, W]](),
+	}
+}
+
+// NewUndirectedGraph creates a new empty undirected graph
+func NewUndirectedGraph[V comparable, W any]() *Graph[V, W] {
+	out := ordered.NewOrderedMap[V, *adjacency[V, W]]()
+	return &Graph[V, W]{
+		out: out,
+		in:  out,
+	}
+}
+
+// IsDirected returns true if the graph is directed
+func (g *Graph[V, W]) IsDirected() bool {
+	return g.directed
+}
+
+// AddVertex adds v and returns true if it was not already present
+func (g *Graph[V, W]) AddVertex(v V) bool {
+	if g.out.Contains(v) {
+		return false
+	}
+	g.out.Put(v, ordered.NewOrderedMap[V, W]())
+	if g.directed {
+		g.in.Put(v, ordered.NewOrderedMap[V, W]())
+	}
+	return true
+}
+
+// RemoveVertex removes v and every edge touching it and returns true if it was present
+func (g *Graph[V, W]) RemoveVertex(v V) bool {
+	if !g.out.Contains(v) {
+		return false
+	}
+	for u := range g.Neighbors(v) {
+		g.RemoveEdge(v, u)
+	}
+	for u := range g.Predecessors(v) {
+		g.RemoveEdge(u, v)
+	}
+	g.out.Delete(v)
+	if g.directed {
+		g.in.Delete(v)
+	}
+	return true
+}
+
+// HasVertex returns true if v is in the graph
+func (g *Graph[V, W]) HasVertex(v V) bool {
+	return g.out.Contains(v)
+}
+
+// AddEdge adds an edge from u to v with the given weight, adding missing vertices.
+// It returns true if the edge is new; otherwise the weight is replaced.
+func (g *Graph[V, W]) AddEdge(u, v V, weight W) bool {
+	g.AddVertex(u)
+	g.AddVertex(v)
+	outU, _ := g.out.Peek(u)
+	added := outU.Put(v, weight)
+	inV, _ := g.in.Peek(v)
+	inV.Put(u, weight)
+	if added {
+		g.edges++
+	}
+	return added
+}
+
+// RemoveEdge removes the edge from u to v and returns true if it was present
+func (g *Graph[V, W]) RemoveEdge(u, v V) bool {
+	outU, ok := g.out.Peek(u)
+	if !ok || !outU.Delete(v) {
+		return false
+	}
+	inV, _ := g.in.Peek(v)
+	inV.Delete(u)
+	g.edges--
+	return true
+}
+
+// HasEdge returns true if there is an edge from u to v
+func (g *Graph[V, W]) HasEdge(u, v V) bool {
+	_, ok := g.Weight(u, v)
+	return ok
+}
+
+// Weight returns the weight of the edge from u to v
+func (g *Graph[V, W]) Weight(u, v V) (W, bool) {
+	outU, ok := g.out.Peek(u)
+	if !ok {
+		return *new(W), false
+	}
+	return outU.Peek(v)
+}
+
+// Neighbors returns an iterator over the vertices reachable from v by one edge and the edge weights
+func (g *Graph[V, W]) Neighbors(v V) iter.Seq2[V, W] {
+	return g.adjacent(g.out, v)
+}
+
+// Predecessors returns an iterator over the vertices with an edge into v and the edge weights.
+// For undirected graphs this is the same as Neighbors.
+func (g *Graph[V, W]) Predecessors(v V) iter.Seq2[V, W] {
+	return g.adjacent(g.in, v)
+}
+
+// OutDegree returns the number of edges leaving v
+func (g *Graph[V, W]) OutDegree(v V) int {
+	outV, ok := g.out.Peek(v)
+	if !ok {
+		return 0
+	}
+	return outV.Size()
+}
+
+// InDegree returns the number of edges entering v
+func (g *Graph[V, W]) InDegree(v V) int {
+	inV, ok := g.in.Peek(v)
+	if !ok {
+		return 0
+	}
+	return inV.Size()
+}
+
+// Vertices returns an iterator over all vertices in insertion order
+func (g *Graph[V, W]) Vertices() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range g.out.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Edges returns an iterator over all edges.
+// Each undirected edge is yielded once.
+func (g *Graph[V, W]) Edges() iter.Seq[Edge[V, W]] {
+	return func(yield func(Edge[V, W]) bool) {
+		var seen map[V]bool
+		if !g.directed {
+			seen = make(map[V]bool, g.out.Size())
+		}
+		for u, outU := range g.out.All() {
+			for v, w := range outU.All() {
+				if seen[v] {
+					continue
+				}
+				if !yield(Edge[V, W]{From: u, To: v, Weight: w}) {
+					return
+				}
+			}
+			if seen != nil {
+				seen[u] = true
+			}
+		}
+	}
+}
+
+// VertexCount returns the number of vertices
+func (g *Graph[V, W]) VertexCount() int {
+	return g.out.Size()
+}
+
+// EdgeCount returns the number of edges
+func (g *Graph[V, W]) EdgeCount() int {
+	return g.edges
+}
+
+// IsEmpty returns true if the graph has no vertices
+func (g *Graph[V, W]) IsEmpty() bool {
+	return g.out.IsEmpty()
+}
+
+// Clear removes all vertices and edges
+func (g *Graph[V, W]) Clear() {
+	g.out.Clear()
+	g.in.Clear()
+	g.edges = 0
+}
+
+// String returns a string representation of the adjacency lists
+func (g *Graph[V, W]) String() string {
+	if g.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for u, outU := range g.out.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v:%v", u, outU.Keys()))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// adjacent returns an iterator over the entries of v's list in lists
+func (g *Graph[V, W]) adjacent(lists *ordered.OrderedMap[V, *adjacency[V, W]], v V) iter.Seq2[V, W] {
+	return func(yield func(V, W) bool) {
+		list, ok := lists.Peek(v)
+		if !ok {
+			return
+		}
+		for u, w := range list.All() {
+			if !yield(u, w) {
+				return
+			}
+		}
+	}
+}
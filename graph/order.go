@@ -0,0 +1,213 @@
+package graph
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/abhishekR-tech/collections/linear"
+	"golang.org/x/exp/constraints"
+)
+
+// TopologicalSort returns the vertices of a directed acyclic graph so that every edge
+// points forward, using Kahn's algorithm. Ties keep insertion order.
+// It returns an error if the graph is undirected or contains a cycle.
+func (g *Graph[V, W]) TopologicalSort() ([]V, error) {
+	if !g.directed {
+		return nil, errors.New("graph is not directed")
+	}
+	indegree := make(map[V]int, g.VertexCount())
+	queue := linear.NewQueue[V]()
+	for v := range g.Vertices() {
+		indegree[v] = g.InDegree(v)
+		if indegree[v] == 0 {
+			queue.Enqueue(v)
+		}
+	}
+	return g.kahn(indegree, queue.Enqueue, queue.Dequeue, queue.IsEmpty)
+}
+
+// LexicographicTopologicalSort returns the smallest topological order of a directed
+// acyclic graph, always taking the least available vertex next.
+// It returns an error if the graph is undirected or contains a cycle.
+func LexicographicTopologicalSort[V constraints.Ordered, W any](g *Graph[V, W]) ([]V, error) {
+	if !g.directed {
+		return nil, errors.New("graph is not directed")
+	}
+	indegree := make(map[V]int, g.VertexCount())
+	heap := linear.NewMinHeap[V]()
+	for v := range g.Vertices() {
+		indegree[v] = g.InDegree(v)
+		if indegree[v] == 0 {
+			heap.Push(v)
+		}
+	}
+	return g.kahn(indegree, heap.Push, heap.Pop, heap.IsEmpty)
+}
+
+// FindCycle returns the vertices of a cycle in path order, so the last vertex has an edge
+// back to the first. It returns false if the graph is acyclic.
+// In undirected graphs a single edge walked both ways is not a cycle.
+func (g *Graph[V, W]) FindCycle() ([]V, bool) {
+	const (
+		unvisited = iota
+		active
+		finished
+	)
+	state := make(map[V]int, g.VertexCount())
+	var path []V
+
+	var visit func(u V, parent *V) []V
+	visit = func(u V, parent *V) []V {
+		state[u] = active
+		path = append(path, u)
+		for v := range g.Neighbors(u) {
+			// Walking straight back along an undirected edge is not a cycle
+			if !g.directed && parent != nil && v == *parent {
+				continue
+			}
+			switch state[v] {
+			case active:
+				start := slices.Index(path, v)
+				return slices.Clone(path[start:])
+			case unvisited:
+				if cycle := visit(v, &u); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		state[u] = finished
+		path = path[:len(path)-1]
+		return nil
+	}
+
+	for v := range g.Vertices() {
+		if state[v] == unvisited {
+			if cycle := visit(v, nil); cycle != nil {
+				return cycle, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// HasCycle returns true if the graph contains a cycle
+func (g *Graph[V, W]) HasCycle() bool {
+	_, ok := g.FindCycle()
+	return ok
+}
+
+// TarjanSCC returns the strongly connected components using Tarjan's algorithm.
+// Components come out in reverse topological order of the condensed graph.
+func (g *Graph[V, W]) TarjanSCC() [][]V {
+	index := make(map[V]int, g.VertexCount())
+	low := make(map[V]int, g.VertexCount())
+	onStack := make(map[V]bool)
+	stack := linear.NewStack[V]()
+	var components [][]V
+
+	var connect func(u V)
+	connect = func(u V) {
+		index[u] = len(index)
+		low[u] = index[u]
+		stack.Push(u)
+		onStack[u] = true
+
+		for v := range g.Neighbors(u) {
+			if _, seen := index[v]; !seen {
+				connect(v)
+				low[u] = min(low[u], low[v])
+			} else if onStack[v] {
+				low[u] = min(low[u], index[v])
+			}
+		}
+
+		// u is the root of a component, which sits on top of the stack
+		if low[u] == index[u] {
+			var component []V
+			for {
+				v, _ := stack.Pop()
+				onStack[v] = false
+				component = append(component, v)
+				if v == u {
+					break
+				}
+			}
+			slices.Reverse(component)
+			components = append(components, component)
+		}
+	}
+
+	for v := range g.Vertices() {
+		if _, seen := index[v]; !seen {
+			connect(v)
+		}
+	}
+	return components
+}
+
+// KosarajuSCC returns the strongly connected components using Kosaraju's algorithm.
+// Components come out in topological order of the condensed graph.
+func (g *Graph[V, W]) KosarajuSCC() [][]V {
+	visited := make(map[V]bool, g.VertexCount())
+	finished := make([]V, 0, g.VertexCount())
+
+	var visit func(u V)
+	visit = func(u V) {
+		visited[u] = true
+		for v := range g.Neighbors(u) {
+			if !visited[v] {
+				visit(v)
+			}
+		}
+		finished = append(finished, u)
+	}
+	for v := range g.Vertices() {
+		if !visited[v] {
+			visit(v)
+		}
+	}
+
+	// Sweep the transposed graph in reverse finishing order
+	assigned := make(map[V]bool, g.VertexCount())
+	var components [][]V
+	for _, s := range slices.Backward(finished) {
+		if assigned[s] {
+			continue
+		}
+		assigned[s] = true
+		component := []V{s}
+		stack := linear.NewStack[V]()
+		stack.Push(s)
+		for !stack.IsEmpty() {
+			u, _ := stack.Pop()
+			for v := range g.Predecessors(u) {
+				if !assigned[v] {
+					assigned[v] = true
+					component = append(component, v)
+					stack.Push(v)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// kahn repeatedly removes a vertex with no remaining incoming edges from the frontier
+func (g *Graph[V, W]) kahn(indegree map[V]int, push func(V), pop func() (V, error), isEmpty func() bool) ([]V, error) {
+	order := make([]V, 0, g.VertexCount())
+	for !isEmpty() {
+		u, _ := pop()
+		order = append(order, u)
+		for v := range g.Neighbors(u) {
+			indegree[v]--
+			if indegree[v] == 0 {
+				push(v)
+			}
+		}
+	}
+	if len(order) < g.VertexCount() {
+		return nil, errors.New("graph contains a cycle")
+	}
+	return order, nil
+}
@@ -0,0 +1,153 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/abhishekR-tech/collections/linear"
+	"golang.org/x/exp/constraints"
+)
+
+// Number is the set of edge weight types usable by the weighted algorithms
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Paths holds the result of a single-source shortest path search
+type Paths[V comparable, W Number] struct {
+	// Source is the vertex the search started from
+	Source V
+	// Dist maps each reached vertex to its distance from Source
+	Dist map[V]W
+	// Prev maps each reached vertex other than Source to its predecessor on a shortest path
+	Prev map[V]V
+}
+
+// DistanceTo returns the distance from the source to v
+func (p *Paths[V, W]) DistanceTo(v V) (W, bool) {
+	d, ok := p.Dist[v]
+	return d, ok
+}
+
+// PathTo returns the vertices of a shortest path from the source to v.
+// It returns false if v was not reached.
+func (p *Paths[V, W]) PathTo(v V) ([]V, bool) {
+	if _, ok := p.Dist[v]; !ok {
+		return nil, false
+	}
+	return buildPath(p.Prev, p.Source, v), true
+}
+
+// queued is a vertex waiting in a priority queue with its search priority
+type queued[V comparable, W Number] struct {
+	vertex   V
+	dist     W
+	priority W
+}
+
+// Dijkstra finds shortest paths from source to every reachable vertex in O((V + E) log V).
+// It returns an error if it meets a negative edge weight.
+func Dijkstra[V comparable, W Number](g *Graph[V, W], source V) (*Paths[V, W], error) {
+	return search(g, source, nil, nil)
+}
+
+// AStar finds a shortest path from source to target, guided by heuristic,
+// which estimates the remaining distance from a vertex to target.
+// The path is optimal if the heuristic never overestimates.
+// The search stops once target is settled, so Paths only covers the explored vertices.
+// It returns an error if it meets a negative edge weight.
+func AStar[V comparable, W Number](g *Graph[V, W], source, target V, heuristic func(v V) W) (*Paths[V, W], error) {
+	return search(g, source, &target, heuristic)
+}
+
+// BellmanFord finds shortest paths from source to every reachable vertex in O(V * E),
+// allowing negative edge weights.
+// It returns an error if a negative cycle is reachable from source.
+func BellmanFord[V comparable, W Number](g *Graph[V, W], source V) (*Paths[V, W], error) {
+	paths := newPaths[V, W](source)
+	if !g.HasVertex(source) {
+		return paths, nil
+	}
+	paths.Dist[source] = 0
+
+	relax := func() bool {
+		changed := false
+		for u := range g.Vertices() {
+			du, ok := paths.Dist[u]
+			if !ok {
+				continue
+			}
+			for v, w := range g.Neighbors(u) {
+				if dv, ok := paths.Dist[v]; !ok || du+w < dv {
+					paths.Dist[v] = du + w
+					paths.Prev[v] = u
+					changed = true
+				}
+			}
+		}
+		return changed
+	}
+
+	for range g.VertexCount() - 1 {
+		if !relax() {
+			return paths, nil
+		}
+	}
+	if relax() {
+		return nil, errors.New("negative cycle reachable from source")
+	}
+	return paths, nil
+}
+
+// search runs Dijkstra's algorithm, or A* when a heuristic and target are given
+func search[V comparable, W Number](g *Graph[V, W], source V, target *V, heuristic func(v V) W) (*Paths[V, W], error) {
+	paths := newPaths[V, W](source)
+	if !g.HasVertex(source) {
+		return paths, nil
+	}
+	estimate := func(v V) W {
+		if heuristic == nil {
+			return 0
+		}
+		return heuristic(v)
+	}
+
+	paths.Dist[source] = 0
+	pq := linear.NewPriorityQueue(func(a, b queued[V, W]) bool {
+		return a.priority < b.priority
+	})
+	pq.Push(queued[V, W]{vertex: source, priority: estimate(source)})
+
+	for !pq.IsEmpty() {
+		item, _ := pq.Pop()
+		u := item.vertex
+		// Skip stale entries left behind by later improvements
+		if item.dist > paths.Dist[u] {
+			continue
+		}
+		if target != nil && u == *target {
+			break
+		}
+		for v, w := range g.Neighbors(u) {
+			if w < 0 {
+				return nil, errors.New("negative edge weight")
+			}
+			d := item.dist + w
+			if dv, ok := paths.Dist[v]; ok && dv <= d {
+				continue
+			}
+			paths.Dist[v] = d
+			paths.Prev[v] = u
+			pq.Push(queued[V, W]{vertex: v, dist: d, priority: d + estimate(v)})
+		}
+	}
+	return paths, nil
+}
+
+// newPaths creates an empty search result rooted at source
+func newPaths[V comparable, W Number](source V) *Paths[V, W] {
+	return &Paths[V, W]{
+		Source: source,
+		Dist:   make(map[V]W),
+		Prev:   make(map[V]V),
+	}
+}
@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/abhishekR-tech/collections/linear"
+	"github.com/abhishekR-tech/collections/set"
+)
+
+// Kruskal returns the edges and total weight of a minimum spanning forest,
+// adding edges in order of weight and joining trees with a disjoint set.
+// Edge direction is ignored.
+func Kruskal[V comparable, W Number](g *Graph[V, W]) ([]Edge[V, W], W) {
+	edges := slices.Collect(g.Edges())
+	slices.SortStableFunc(edges, func(a, b Edge[V, W]) int {
+		return cmp.Compare(a.Weight, b.Weight)
+	})
+
+	trees := set.NewDisjointSet[V]()
+	for v := range g.Vertices() {
+		trees.Add(v)
+	}
+
+	var forest []Edge[V, W]
+	var total W
+	for _, e := range edges {
+		if trees.Union(e.From, e.To) {
+			forest = append(forest, e)
+			total += e.Weight
+		}
+	}
+	return forest, total
+}
+
+// Prim returns the edges and total weight of a minimum spanning forest,
+// growing each tree from its cheapest crossing edge kept in a priority queue.
+// Edge direction is ignored.
+func Prim[V comparable, W Number](g *Graph[V, W]) ([]Edge[V, W], W) {
+	inTree := make(map[V]bool, g.VertexCount())
+	pq := linear.NewPriorityQueue(func(a, b Edge[V, W]) bool {
+		return a.Weight < b.Weight
+	})
+	grow := func(u V) {
+		inTree[u] = true
+		for v, w := range g.Neighbors(u) {
+			if !inTree[v] {
+				pq.Push(Edge[V, W]{From: u, To: v, Weight: w})
+			}
+		}
+		if g.directed {
+			for v, w := range g.Predecessors(u) {
+				if !inTree[v] {
+					pq.Push(Edge[V, W]{From: v, To: u, Weight: w})
+				}
+			}
+		}
+	}
+
+	var forest []Edge[V, W]
+	var total W
+	for s := range g.Vertices() {
+		if inTree[s] {
+			continue
+		}
+		grow(s)
+		for !pq.IsEmpty() {
+			e, _ := pq.Pop()
+			next := e.To
+			if inTree[next] {
+				next = e.From
+			}
+			if inTree[next] {
+				continue
+			}
+			forest = append(forest, e)
+			total += e.Weight
+			grow(next)
+		}
+	}
+	return forest, total
+}
@@ -0,0 +1,188 @@
+package graph
+
+import (
+	"errors"
+	"iter"
+	"slices"
+
+	"github.com/abhishekR-tech/collections/linear"
+	"golang.org/x/exp/constraints"
+)
+
+// BFS returns an iterator over the vertices reachable from start in breadth-first order
+func (g *Graph[V, W]) BFS(start V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if !g.HasVertex(start) {
+			return
+		}
+		visited := map[V]bool{start: true}
+		queue := linear.NewQueue[V]()
+		queue.Enqueue(start)
+		for !queue.IsEmpty() {
+			u, _ := queue.Dequeue()
+			if !yield(u) {
+				return
+			}
+			for v := range g.Neighbors(u) {
+				if !visited[v] {
+					visited[v] = true
+					queue.Enqueue(v)
+				}
+			}
+		}
+	}
+}
+
+// DFS returns an iterator over the vertices reachable from start in depth-first preorder.
+// Neighbors are explored in insertion order.
+func (g *Graph[V, W]) DFS(start V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if !g.HasVertex(start) {
+			return
+		}
+		visited := make(map[V]bool)
+		stack := linear.NewStack[V]()
+		stack.Push(start)
+		var next []V
+		for !stack.IsEmpty() {
+			u, _ := stack.Pop()
+			if visited[u] {
+				continue
+			}
+			visited[u] = true
+			if !yield(u) {
+				return
+			}
+			// Push in reverse so the first neighbor is explored first
+			next = next[:0]
+			for v := range g.Neighbors(u) {
+				if !visited[v] {
+					next = append(next, v)
+				}
+			}
+			for _, v := range slices.Backward(next) {
+				stack.Push(v)
+			}
+		}
+	}
+}
+
+// HopDistances returns the number of edges on a shortest path from start to every reachable vertex
+func (g *Graph[V, W]) HopDistances(start V) map[V]int {
+	dist, _ := g.hopSearch(start, nil)
+	return dist
+}
+
+// ShortestHopPath returns a path from start to end with the fewest edges.
+// It returns false if end is not reachable from start.
+func (g *Graph[V, W]) ShortestHopPath(start, end V) ([]V, bool) {
+	dist, prev := g.hopSearch(start, &end)
+	if _, ok := dist[end]; !ok {
+		return nil, false
+	}
+	return buildPath(prev, start, end), true
+}
+
+// ConnectedComponents returns the vertex groups connected by edges, ignoring edge direction.
+// Components and their vertices follow insertion and breadth-first order.
+func (g *Graph[V, W]) ConnectedComponents() [][]V {
+	visited := make(map[V]bool, g.VertexCount())
+	var components [][]V
+	for s := range g.Vertices() {
+		if visited[s] {
+			continue
+		}
+		visited[s] = true
+		component := []V{s}
+		queue := linear.NewQueue[V]()
+		queue.Enqueue(s)
+		for !queue.IsEmpty() {
+			u, _ := queue.Dequeue()
+			for _, adjacent := range []iter.Seq2[V, W]{g.Neighbors(u), g.Predecessors(u)} {
+				for v := range adjacent {
+					if !visited[v] {
+						visited[v] = true
+						component = append(component, v)
+						queue.Enqueue(v)
+					}
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// ZeroOneBFS returns the shortest distance from start to every reachable vertex
+// of a graph whose edge weights are all 0 or 1, in O(V + E) using a deque.
+// It returns an error if it meets any other weight.
+func ZeroOneBFS[V comparable, W constraints.Integer](g *Graph[V, W], start V) (map[V]W, error) {
+	dist := make(map[V]W)
+	if !g.HasVertex(start) {
+		return dist, nil
+	}
+	dist[start] = 0
+	deque := linear.NewDeque[V]()
+	deque.AddFirst(start)
+	done := make(map[V]bool)
+	for !deque.IsEmpty() {
+		u, _ := deque.RemoveFirst()
+		if done[u] {
+			continue
+		}
+		done[u] = true
+		for v, w := range g.Neighbors(u) {
+			if w != 0 && w != 1 {
+				return nil, errors.New("edge weight must be 0 or 1")
+			}
+			if d, ok := dist[v]; ok && d <= dist[u]+w {
+				continue
+			}
+			dist[v] = dist[u] + w
+			// Zero-weight edges keep the same distance, so they go to the front
+			if w == 0 {
+				deque.AddFirst(v)
+			} else {
+				deque.AddLast(v)
+			}
+		}
+	}
+	return dist, nil
+}
+
+// hopSearch runs a breadth-first search from start, stopping early once end is reached
+func (g *Graph[V, W]) hopSearch(start V, end *V) (map[V]int, map[V]V) {
+	dist := make(map[V]int)
+	prev := make(map[V]V)
+	if !g.HasVertex(start) {
+		return dist, prev
+	}
+	dist[start] = 0
+	queue := linear.NewQueue[V]()
+	queue.Enqueue(start)
+	for !queue.IsEmpty() {
+		u, _ := queue.Dequeue()
+		if end != nil && u == *end {
+			break
+		}
+		for v := range g.Neighbors(u) {
+			if _, ok := dist[v]; !ok {
+				dist[v] = dist[u] + 1
+				prev[v] = u
+				queue.Enqueue(v)
+			}
+		}
+	}
+	return dist, prev
+}
+
+// buildPath follows predecessor links back from end to start and returns the path in order
+func buildPath[V comparable](prev map[V]V, start, end V) []V {
+	path := []V{end}
+	for v := end; v != start; {
+		v = prev[v]
+		path = append(path, v)
+	}
+	slices.Reverse(path)
+	return path
+}
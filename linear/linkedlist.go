@@ -24,38 +24,158 @@ func NewLinkedList[T any]() *LinkedList[T] {
 
 // Append adds an item to the end of the linked list
 func (ll *LinkedList[T]) Append(item T) {
+	ll.PushBack(item)
+}
+
+// Prepend adds an item to the beginning of the linked list
+func (ll *LinkedList[T]) Prepend(item T) {
+	ll.PushFront(item)
+}
+
+// PushBack adds an item to the end of the linked list and returns its node
+func (ll *LinkedList[T]) PushBack(item T) *Node[T] {
 	newNode := &Node[T]{
 		Value: item,
-		Prev:  ll.tail,
+		Prev:  nil,
 		Next:  nil,
 	}
+	ll.linkBack(newNode)
+	ll.length++
+	return newNode
+}
 
-	if ll.tail != nil {
-		ll.tail.Next = newNode
+// PushFront adds an item to the beginning of the linked list and returns its node
+func (ll *LinkedList[T]) PushFront(item T) *Node[T] {
+	newNode := &Node[T]{
+		Value: item,
+		Prev:  nil,
+		Next:  nil,
+	}
+	ll.linkFront(newNode)
+	ll.length++
+	return newNode
+}
+
+// InsertBefore adds an item immediately before mark and returns its node.
+// The mark node must belong to this list.
+func (ll *LinkedList[T]) InsertBefore(item T, mark *Node[T]) *Node[T] {
+	newNode := &Node[T]{
+		Value: item,
+		Prev:  mark.Prev,
+		Next:  mark,
+	}
+
+	if mark.Prev != nil {
+		mark.Prev.Next = newNode
 	} else {
 		ll.head = newNode
 	}
 
-	ll.tail = newNode
+	mark.Prev = newNode
 	ll.length++
+	return newNode
 }
 
-// Prepend adds an item to the beginning of the linked list
-func (ll *LinkedList[T]) Prepend(item T) {
+// InsertAfter adds an item immediately after mark and returns its node.
+// The mark node must belong to this list.
+func (ll *LinkedList[T]) InsertAfter(item T, mark *Node[T]) *Node[T] {
 	newNode := &Node[T]{
 		Value: item,
-		Prev:  nil,
-		Next:  ll.head,
+		Prev:  mark,
+		Next:  mark.Next,
 	}
 
-	if ll.head != nil {
-		ll.head.Prev = newNode
+	if mark.Next != nil {
+		mark.Next.Prev = newNode
 	} else {
 		ll.tail = newNode
 	}
 
-	ll.head = newNode
+	mark.Next = newNode
 	ll.length++
+	return newNode
+}
+
+// Front returns the first node of the linked list, or nil if it is empty
+func (ll *LinkedList[T]) Front() *Node[T] {
+	return ll.head
+}
+
+// Back returns the last node of the linked list, or nil if it is empty
+func (ll *LinkedList[T]) Back() *Node[T] {
+	return ll.tail
+}
+
+// Remove unlinks node from the linked list and returns its value.
+// The node must belong to this list.
+func (ll *LinkedList[T]) Remove(node *Node[T]) T {
+	ll.unlink(node)
+	ll.length--
+	return node.Value
+}
+
+// MoveToFront moves node to the beginning of the linked list.
+// The node must belong to this list.
+func (ll *LinkedList[T]) MoveToFront(node *Node[T]) {
+	if ll.head == node {
+		return
+	}
+	ll.unlink(node)
+	ll.linkFront(node)
+}
+
+// MoveToBack moves node to the end of the linked list.
+// The node must belong to this list.
+func (ll *LinkedList[T]) MoveToBack(node *Node[T]) {
+	if ll.tail == node {
+		return
+	}
+	ll.unlink(node)
+	ll.linkBack(node)
+}
+
+// linkFront attaches a detached node before the head
+func (ll *LinkedList[T]) linkFront(node *Node[T]) {
+	node.Prev = nil
+	node.Next = ll.head
+
+	if ll.head != nil {
+		ll.head.Prev = node
+	} else {
+		ll.tail = node
+	}
+
+	ll.head = node
+}
+
+// linkBack attaches a detached node after the tail
+func (ll *LinkedList[T]) linkBack(node *Node[T]) {
+	node.Prev = ll.tail
+	node.Next = nil
+
+	if ll.tail != nil {
+		ll.tail.Next = node
+	} else {
+		ll.head = node
+	}
+
+	ll.tail = node
+}
+
+// unlink detaches node from its neighbours without changing the length
+func (ll *LinkedList[T]) unlink(node *Node[T]) {
+	if node.Prev != nil {
+		node.Prev.Next = node.Next
+	} else {
+		ll.head = node.Next
+	}
+	if node.Next != nil {
+		node.Next.Prev = node.Prev
+	} else {
+		ll.tail = node.Prev
+	}
+	node.Prev = nil
+	node.Next = nil
 }
 
 // Insert adds an item at the specified index in the linked list
@@ -0,0 +1,158 @@
+package linear
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PriorityQueue represents a binary heap ordered by a custom less function.
+// The element for which less reports true against every other element is
+// always at the front of the queue.
+type PriorityQueue[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// NewPriorityQueue creates and returns a new empty priority queue
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		items: make([]T, 0),
+		less:  less,
+	}
+}
+
+// FromPriorityQueueSlice creates a new priority queue from a slice in O(n)
+func FromPriorityQueueSlice[T any](slice []T, less func(a, b T) bool) *PriorityQueue[T] {
+	pq := NewPriorityQueue(less)
+	pq.items = make([]T, len(slice))
+	copy(pq.items, slice)
+	for i := len(pq.items)/2 - 1; i >= 0; i-- {
+		pq.heapifyDown(i)
+	}
+	return pq
+}
+
+// IsEmpty returns true if the priority queue has no items
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.items) == 0
+}
+
+// Size returns the number of items in the priority queue
+func (pq *PriorityQueue[T]) Size() int {
+	return len(pq.items)
+}
+
+// Clear removes all items from the priority queue
+func (pq *PriorityQueue[T]) Clear() {
+	pq.items = make([]T, 0)
+}
+
+// Push adds an item to the priority queue
+func (pq *PriorityQueue[T]) Push(item T) {
+	pq.items = append(pq.items, item)
+	pq.heapifyUp(len(pq.items) - 1)
+}
+
+// Pop removes and returns the front item of the priority queue
+func (pq *PriorityQueue[T]) Pop() (T, error) {
+	if pq.IsEmpty() {
+		return *new(T), errors.New("empty priority queue")
+	}
+
+	front := pq.items[0]
+	lastIdx := len(pq.items) - 1
+	pq.items[0] = pq.items[lastIdx]
+	pq.items[lastIdx] = *new(T)
+	pq.items = pq.items[:lastIdx]
+
+	if !pq.IsEmpty() {
+		pq.heapifyDown(0)
+	}
+
+	return front, nil
+}
+
+// Peek returns the front item without removing it
+func (pq *PriorityQueue[T]) Peek() (T, error) {
+	if pq.IsEmpty() {
+		return *new(T), errors.New("empty priority queue")
+	}
+	return pq.items[0], nil
+}
+
+// Replace pops the front item and pushes item in a single sift,
+// which is cheaper than a Pop followed by a Push
+func (pq *PriorityQueue[T]) Replace(item T) (T, error) {
+	if pq.IsEmpty() {
+		return *new(T), errors.New("empty priority queue")
+	}
+
+	front := pq.items[0]
+	pq.items[0] = item
+	pq.heapifyDown(0)
+	return front, nil
+}
+
+// ToSlice returns a copy of the priority queue items in heap order
+func (pq *PriorityQueue[T]) ToSlice() []T {
+	result := make([]T, len(pq.items))
+	copy(result, pq.items)
+	return result
+}
+
+// String returns a string representation of the priority queue in heap order
+func (pq *PriorityQueue[T]) String() string {
+	if pq.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	for i, item := range pq.items {
+		sb.WriteString(fmt.Sprintf("%v", item))
+		if i < len(pq.items)-1 {
+			sb.WriteString(" ")
+		}
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// heapifyUp maintains heap property by moving element up
+func (pq *PriorityQueue[T]) heapifyUp(index int) {
+	for index > 0 {
+		parentIdx := (index - 1) / 2
+		if !pq.less(pq.items[index], pq.items[parentIdx]) {
+			break
+		}
+		pq.items[index], pq.items[parentIdx] = pq.items[parentIdx], pq.items[index]
+		index = parentIdx
+	}
+}
+
+// heapifyDown maintains heap property by moving element down
+func (pq *PriorityQueue[T]) heapifyDown(index int) {
+	size := len(pq.items)
+	for {
+		best := index
+		leftChild := 2*index + 1
+		rightChild := 2*index + 2
+
+		if leftChild < size && pq.less(pq.items[leftChild], pq.items[best]) {
+			best = leftChild
+		}
+		if rightChild < size && pq.less(pq.items[rightChild], pq.items[best]) {
+			best = rightChild
+		}
+
+		if best == index {
+			break
+		}
+
+		pq.items[index], pq.items[best] = pq.items[best], pq.items[index]
+		index = best
+	}
+}
@@ -0,0 +1,338 @@
+package linear
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// skipListMaxLevel caps the number of levels, enough for 4^32 entries
+const skipListMaxLevel = 32
+
+// skipNode represents an entry of a SkipList.
+// span[i] counts the bottom-level steps that next[i] skips over.
+type skipNode[K any, V any] struct {
+	key   K
+	value V
+	next  []*skipNode[K, V]
+	span  []int
+}
+
+// SkipList represents a sorted map built from layered linked lists.
+// Each entry is promoted to a higher level with probability 1/4, giving
+// expected O(log n) search, insertion and deletion. Every link also records
+// how many entries it skips, so positional access by At and Rank is O(log n) too.
+//
+// Updates only relink the nodes next to the affected key and never rebalance,
+// which keeps critical sections short when the list is guarded by a lock.
+// A SkipList is not safe for concurrent use; callers that share one between
+// goroutines must synchronize access themselves, for example with a sync.RWMutex.
+type SkipList[K any, V any] struct {
+	head    *skipNode[K, V]
+	level   int
+	size    int
+	compare func(a, b K) int
+	rng     *rand.Rand
+}
+
+// NewSkipList creates a new empty SkipList ordered by the natural order of K
+func NewSkipList[K constraints.Ordered, V any]() *SkipList[K, V] {
+	return NewSkipListFunc[K, V](cmp.Compare[K])
+}
+
+// NewSkipListFunc creates a new empty SkipList ordered by compare
+func NewSkipListFunc[K any, V any](compare func(a, b K) int) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head: &skipNode[K, V]{
+			next: make([]*skipNode[K, V], skipListMaxLevel),
+			span: make([]int, skipListMaxLevel),
+		},
+		level:   1,
+		compare: compare,
+		rng:     rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+	}
+}
+
+// Seed resets the random level generator so the list shape is reproducible
+func (sl *SkipList[K, V]) Seed(seed uint64) {
+	sl.rng = rand.New(rand.NewPCG(seed, seed))
+}
+
+// Put associates value with key, replacing any previous value.
+// It returns true if the key is new.
+func (sl *SkipList[K, V]) Put(key K, value V) bool {
+	var update [skipListMaxLevel]*skipNode[K, V]
+	var rank [skipListMaxLevel]int
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		if i < sl.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && sl.compare(x.next[i].key, key) < 0 {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	if next := x.next[0]; next != nil && sl.compare(next.key, key) == 0 {
+		next.value = value
+		return false
+	}
+
+	level := sl.randomLevel()
+	for i := sl.level; i < level; i++ {
+		update[i] = sl.head
+		sl.head.span[i] = sl.size
+	}
+	sl.level = max(sl.level, level)
+
+	n := &skipNode[K, V]{
+		key:   key,
+		value: value,
+		next:  make([]*skipNode[K, V], level),
+		span:  make([]int, level),
+	}
+	for i := range level {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+		// rank[0] - rank[i] is the distance from update[i] to the new node's predecessor
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	for i := level; i < sl.level; i++ {
+		update[i].span[i]++
+	}
+	sl.size++
+	return true
+}
+
+// Get returns the value associated with key
+func (sl *SkipList[K, V]) Get(key K) (V, bool) {
+	n := sl.predecessor(key).next[0]
+	if n == nil || sl.compare(n.key, key) != 0 {
+		return *new(V), false
+	}
+	return n.value, true
+}
+
+// Contains returns true if key is in the list
+func (sl *SkipList[K, V]) Contains(key K) bool {
+	_, ok := sl.Get(key)
+	return ok
+}
+
+// Delete removes key and returns true if it was present
+func (sl *SkipList[K, V]) Delete(key K) bool {
+	var update [skipListMaxLevel]*skipNode[K, V]
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && sl.compare(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	n := x.next[0]
+	if n == nil || sl.compare(n.key, key) != 0 {
+		return false
+	}
+
+	for i := range sl.level {
+		if update[i].next[i] == n {
+			update[i].span[i] += n.span[i] - 1
+			update[i].next[i] = n.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for sl.level > 1 && sl.head.next[sl.level-1] == nil {
+		sl.level--
+	}
+	sl.size--
+	return true
+}
+
+// At returns the entry at index i of the sorted order, counting from 0
+func (sl *SkipList[K, V]) At(i int) (K, V, error) {
+	if i < 0 || i >= sl.size {
+		return *new(K), *new(V), errors.New("index out of bounds")
+	}
+	target := i + 1
+	traversed := 0
+	x := sl.head
+	for l := sl.level - 1; l >= 0; l-- {
+		for x.next[l] != nil && traversed+x.span[l] <= target {
+			traversed += x.span[l]
+			x = x.next[l]
+		}
+		if traversed == target {
+			break
+		}
+	}
+	return x.key, x.value, nil
+}
+
+// Rank returns the number of keys strictly less than key
+func (sl *SkipList[K, V]) Rank(key K) int {
+	rank := 0
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && sl.compare(x.next[i].key, key) < 0 {
+			rank += x.span[i]
+			x = x.next[i]
+		}
+	}
+	return rank
+}
+
+// Min returns the smallest key and its value
+func (sl *SkipList[K, V]) Min() (K, V, bool) {
+	return sl.found(sl.head.next[0])
+}
+
+// Max returns the largest key and its value
+func (sl *SkipList[K, V]) Max() (K, V, bool) {
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil {
+			x = x.next[i]
+		}
+	}
+	if x == sl.head {
+		return *new(K), *new(V), false
+	}
+	return x.key, x.value, true
+}
+
+// Floor returns the largest key less than or equal to key
+func (sl *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	x := sl.predecessor(key)
+	if next := x.next[0]; next != nil && sl.compare(next.key, key) == 0 {
+		return next.key, next.value, true
+	}
+	return sl.found(x)
+}
+
+// Ceiling returns the smallest key greater than or equal to key
+func (sl *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	return sl.found(sl.predecessor(key).next[0])
+}
+
+// Lower returns the largest key strictly less than key
+func (sl *SkipList[K, V]) Lower(key K) (K, V, bool) {
+	return sl.found(sl.predecessor(key))
+}
+
+// Higher returns the smallest key strictly greater than key
+func (sl *SkipList[K, V]) Higher(key K) (K, V, bool) {
+	next := sl.predecessor(key).next[0]
+	if next != nil && sl.compare(next.key, key) == 0 {
+		next = next.next[0]
+	}
+	return sl.found(next)
+}
+
+// Range returns an iterator over the entries with lo <= key <= hi in ascending order
+func (sl *SkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := sl.predecessor(lo).next[0]; n != nil && sl.compare(n.key, hi) <= 0; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over all entries in ascending key order
+func (sl *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := sl.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over all keys in ascending order
+func (sl *SkipList[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for n := sl.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.key) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the number of entries
+func (sl *SkipList[K, V]) Size() int {
+	return sl.size
+}
+
+// IsEmpty returns true if the list has no entries
+func (sl *SkipList[K, V]) IsEmpty() bool {
+	return sl.size == 0
+}
+
+// Clear removes all entries from the list
+func (sl *SkipList[K, V]) Clear() {
+	clear(sl.head.next)
+	clear(sl.head.span)
+	sl.level = 1
+	sl.size = 0
+}
+
+// String returns a string representation of the list in ascending key order
+func (sl *SkipList[K, V]) String() string {
+	if sl.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for k, v := range sl.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v:%v", k, v))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// predecessor returns the last node with a key strictly less than key, or the head
+func (sl *SkipList[K, V]) predecessor(key K) *skipNode[K, V] {
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && sl.compare(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+	}
+	return x
+}
+
+// found unpacks an optional node into a key, value and presence flag, treating the head as absent
+func (sl *SkipList[K, V]) found(n *skipNode[K, V]) (K, V, bool) {
+	if n == nil || n == sl.head {
+		return *new(K), *new(V), false
+	}
+	return n.key, n.value, true
+}
+
+// randomLevel picks a level for a new node, promoting with probability 1/4 per level
+func (sl *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && sl.rng.Uint32()&3 == 0 {
+		level++
+	}
+	return level
+}
@@ -0,0 +1,202 @@
+package linear
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// TopK keeps the k largest items seen so far according to less.
+// It holds at most k items, so it can consume an unbounded stream.
+type TopK[T any] struct {
+	k    int
+	less func(a, b T) bool
+	heap *PriorityQueue[T]
+}
+
+// NewTopK creates a new TopK that retains the k largest items by less
+func NewTopK[T any](k int, less func(a, b T) bool) *TopK[T] {
+	return &TopK[T]{
+		k:    k,
+		less: less,
+		// The smallest retained item sits at the front so it can be evicted
+		heap: NewPriorityQueue(less),
+	}
+}
+
+// Push offers an item, keeping it only if it is among the k largest
+func (t *TopK[T]) Push(item T) {
+	if t.k <= 0 {
+		return
+	}
+	if t.heap.Size() < t.k {
+		t.heap.Push(item)
+		return
+	}
+	if smallest, _ := t.heap.Peek(); t.less(smallest, item) {
+		t.heap.Replace(item)
+	}
+}
+
+// K returns the maximum number of items retained
+func (t *TopK[T]) K() int {
+	return t.k
+}
+
+// IsEmpty returns true if no items are retained
+func (t *TopK[T]) IsEmpty() bool {
+	return t.heap.IsEmpty()
+}
+
+// Size returns the number of items retained
+func (t *TopK[T]) Size() int {
+	return t.heap.Size()
+}
+
+// Clear removes all retained items
+func (t *TopK[T]) Clear() {
+	t.heap.Clear()
+}
+
+// ToSlice returns the retained items sorted from largest to smallest
+func (t *TopK[T]) ToSlice() []T {
+	sorted := FromPriorityQueueSlice(t.heap.ToSlice(), t.less)
+	result := make([]T, sorted.Size())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i], _ = sorted.Pop()
+	}
+	return result
+}
+
+// String returns a string representation of the retained items, largest first
+func (t *TopK[T]) String() string {
+	items := t.ToSlice()
+	if len(items) == 0 {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	for i, item := range items {
+		sb.WriteString(fmt.Sprintf("%v", item))
+		if i < len(items)-1 {
+			sb.WriteString(" ")
+		}
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// MergeK lazily merges sorted sequences into a single sorted sequence.
+// Each input must already be sorted by cmp; ties are yielded in the order
+// the sequences were passed.
+func MergeK[T any](cmp func(a, b T) int, seqs ...iter.Seq[T]) iter.Seq[T] {
+	type cursor struct {
+		value T
+		index int
+		next  func() (T, bool)
+	}
+
+	return func(yield func(T) bool) {
+		heap := NewPriorityQueue(func(a, b *cursor) bool {
+			if c := cmp(a.value, b.value); c != 0 {
+				return c < 0
+			}
+			return a.index < b.index
+		})
+
+		stops := make([]func(), 0, len(seqs))
+		defer func() {
+			for _, stop := range stops {
+				stop()
+			}
+		}()
+
+		for i, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			stops = append(stops, stop)
+			if value, ok := next(); ok {
+				heap.Push(&cursor{value: value, index: i, next: next})
+			}
+		}
+
+		for !heap.IsEmpty() {
+			c, _ := heap.Peek()
+			if !yield(c.value) {
+				return
+			}
+			if value, ok := c.next(); ok {
+				c.value = value
+				heap.Replace(c)
+			} else {
+				heap.Pop()
+			}
+		}
+	}
+}
+
+// NSmallest returns the n smallest items in ascending order
+func NSmallest[T constraints.Ordered](n int, items []T) []T {
+	if n <= 0 {
+		return []T{}
+	}
+
+	// Keep the n smallest seen so far with the largest of them on top
+	heap := NewMaxHeap[T]()
+	for _, item := range items {
+		if heap.Size() < n {
+			heap.Push(item)
+			continue
+		}
+		if top, _ := heap.Peek(); item < top {
+			heap.Pop()
+			heap.Push(item)
+		}
+	}
+
+	result := make([]T, heap.Size())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i], _ = heap.Pop()
+	}
+	return result
+}
+
+// NLargest returns the n largest items in descending order
+func NLargest[T constraints.Ordered](n int, items []T) []T {
+	if n <= 0 {
+		return []T{}
+	}
+
+	// Keep the n largest seen so far with the smallest of them on top
+	heap := NewMinHeap[T]()
+	for _, item := range items {
+		if heap.Size() < n {
+			heap.Push(item)
+			continue
+		}
+		if top, _ := heap.Peek(); item > top {
+			heap.Pop()
+			heap.Push(item)
+		}
+	}
+
+	result := make([]T, heap.Size())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i], _ = heap.Pop()
+	}
+	return result
+}
+
+// NSmallestOf returns the n smallest items of any Sliceable in ascending order
+func NSmallestOf[T constraints.Ordered](n int, source Sliceable[T]) []T {
+	return NSmallest(n, source.ToSlice())
+}
+
+// NLargestOf returns the n largest items of any Sliceable in descending order
+func NLargestOf[T constraints.Ordered](n int, source Sliceable[T]) []T {
+	return NLargest(n, source.ToSlice())
+}
@@ -0,0 +1,213 @@
+package ordered
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/abhishekR-tech/collections/linear"
+)
+
+// entry is a key/value pair stored in the order list
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// OrderedMap represents a hash map that remembers the order of its keys.
+// A map index gives O(1) Put, Get and Delete, and a linear.LinkedList keeps
+// the keys in insertion order, or in access order if requested.
+type OrderedMap[K comparable, V any] struct {
+	index       map[K]*linear.Node[entry[K, V]]
+	order       *linear.LinkedList[entry[K, V]]
+	accessOrder bool
+}
+
+// NewOrderedMap creates a new empty map that iterates in insertion order
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		index: make(map[K]*linear.Node[entry[K, V]]),
+		order: linear.NewLinkedList[entry[K, V]](),
+	}
+}
+
+// NewAccessOrderedMap creates a new empty map that iterates from least to
+// most recently accessed, where both Get and Put count as an access
+func NewAccessOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	m := NewOrderedMap[K, V]()
+	m.accessOrder = true
+	return m
+}
+
+// Put associates value with key and returns true if key was not already present.
+// A new key goes to the end; an existing key keeps its place unless the map is access-ordered.
+func (m *OrderedMap[K, V]) Put(key K, value V) bool {
+	if node, ok := m.index[key]; ok {
+		node.Value.value = value
+		if m.accessOrder {
+			m.order.MoveToBack(node)
+		}
+		return false
+	}
+	m.index[key] = m.order.PushBack(entry[K, V]{key: key, value: value})
+	return true
+}
+
+// Get returns the value associated with key.
+// In an access-ordered map the key also moves to the end.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	node, ok := m.index[key]
+	if !ok {
+		return *new(V), false
+	}
+	if m.accessOrder {
+		m.order.MoveToBack(node)
+	}
+	return node.Value.value, true
+}
+
+// Peek returns the value associated with key without changing the order
+func (m *OrderedMap[K, V]) Peek(key K) (V, bool) {
+	node, ok := m.index[key]
+	if !ok {
+		return *new(V), false
+	}
+	return node.Value.value, true
+}
+
+// Contains returns true if key is in the map, without changing the order
+func (m *OrderedMap[K, V]) Contains(key K) bool {
+	_, ok := m.index[key]
+	return ok
+}
+
+// Delete removes key and returns true if it was present
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	node, ok := m.index[key]
+	if !ok {
+		return false
+	}
+	m.order.Remove(node)
+	delete(m.index, key)
+	return true
+}
+
+// MoveToEnd moves key to the end of the order and returns true if it was present
+func (m *OrderedMap[K, V]) MoveToEnd(key K) bool {
+	node, ok := m.index[key]
+	if !ok {
+		return false
+	}
+	m.order.MoveToBack(node)
+	return true
+}
+
+// MoveToFront moves key to the front of the order and returns true if it was present
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	node, ok := m.index[key]
+	if !ok {
+		return false
+	}
+	m.order.MoveToFront(node)
+	return true
+}
+
+// First returns the first entry in order
+func (m *OrderedMap[K, V]) First() (K, V, bool) {
+	node := m.order.Front()
+	if node == nil {
+		return *new(K), *new(V), false
+	}
+	return node.Value.key, node.Value.value, true
+}
+
+// Last returns the last entry in order
+func (m *OrderedMap[K, V]) Last() (K, V, bool) {
+	node := m.order.Back()
+	if node == nil {
+		return *new(K), *new(V), false
+	}
+	return node.Value.key, node.Value.value, true
+}
+
+// All returns an iterator over the entries from first to last.
+// The map must not be modified during iteration, except by deleting the current key.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := m.order.Front(); node != nil; {
+			next := node.Next
+			if !yield(node.Value.key, node.Value.value) {
+				return
+			}
+			node = next
+		}
+	}
+}
+
+// Backward returns an iterator over the entries from last to first.
+// The map must not be modified during iteration, except by deleting the current key.
+func (m *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := m.order.Back(); node != nil; {
+			prev := node.Prev
+			if !yield(node.Value.key, node.Value.value) {
+				return
+			}
+			node = prev
+		}
+	}
+}
+
+// Keys returns the keys from first to last
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.index))
+	for node := m.order.Front(); node != nil; node = node.Next {
+		keys = append(keys, node.Value.key)
+	}
+	return keys
+}
+
+// Values returns the values from first to last
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, len(m.index))
+	for node := m.order.Front(); node != nil; node = node.Next {
+		values = append(values, node.Value.value)
+	}
+	return values
+}
+
+// Size returns the number of entries in the map
+func (m *OrderedMap[K, V]) Size() int {
+	return len(m.index)
+}
+
+// IsEmpty returns true if the map has no entries
+func (m *OrderedMap[K, V]) IsEmpty() bool {
+	return len(m.index) == 0
+}
+
+// Clear removes all entries from the map
+func (m *OrderedMap[K, V]) Clear() {
+	m.index = make(map[K]*linear.Node[entry[K, V]])
+	m.order.Clear()
+}
+
+// String returns a string representation of the map from first to last
+func (m *OrderedMap[K, V]) String() string {
+	if m.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	for node := m.order.Front(); node != nil; node = node.Next {
+		sb.WriteString(fmt.Sprintf("%v:%v", node.Value.key, node.Value.value))
+		if node.Next != nil {
+			sb.WriteString(" ")
+		}
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
@@ -0,0 +1,130 @@
+package ordered
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// OrderedSet represents a hash set that remembers insertion order
+type OrderedSet[T comparable] struct {
+	m *OrderedMap[T, struct{}]
+}
+
+// NewOrderedSet creates a new empty set that iterates in insertion order
+func NewOrderedSet[T comparable]() *OrderedSet[T] {
+	return &OrderedSet[T]{
+		m: NewOrderedMap[T, struct{}](),
+	}
+}
+
+// FromOrderedSetSlice creates a new set from a slice, keeping the first occurrence of each element
+func FromOrderedSetSlice[T comparable](slice []T) *OrderedSet[T] {
+	s := NewOrderedSet[T]()
+	for _, item := range slice {
+		s.Add(item)
+	}
+	return s
+}
+
+// Add appends item and returns true if it was not already present.
+// An existing element keeps its place.
+func (s *OrderedSet[T]) Add(item T) bool {
+	return s.m.Put(item, struct{}{})
+}
+
+// Remove deletes item and returns true if it was present
+func (s *OrderedSet[T]) Remove(item T) bool {
+	return s.m.Delete(item)
+}
+
+// Contains returns true if item is in the set
+func (s *OrderedSet[T]) Contains(item T) bool {
+	return s.m.Contains(item)
+}
+
+// MoveToEnd moves item to the end of the order and returns true if it was present
+func (s *OrderedSet[T]) MoveToEnd(item T) bool {
+	return s.m.MoveToEnd(item)
+}
+
+// MoveToFront moves item to the front of the order and returns true if it was present
+func (s *OrderedSet[T]) MoveToFront(item T) bool {
+	return s.m.MoveToFront(item)
+}
+
+// First returns the first element in order
+func (s *OrderedSet[T]) First() (T, bool) {
+	item, _, ok := s.m.First()
+	return item, ok
+}
+
+// Last returns the last element in order
+func (s *OrderedSet[T]) Last() (T, bool) {
+	item, _, ok := s.m.Last()
+	return item, ok
+}
+
+// All returns an iterator over the elements from first to last
+func (s *OrderedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s.m.All() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements from last to first
+func (s *OrderedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s.m.Backward() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the number of elements in the set
+func (s *OrderedSet[T]) Size() int {
+	return s.m.Size()
+}
+
+// IsEmpty returns true if the set has no elements
+func (s *OrderedSet[T]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+// Clear removes all elements from the set
+func (s *OrderedSet[T]) Clear() {
+	s.m.Clear()
+}
+
+// ToSlice returns the elements from first to last
+func (s *OrderedSet[T]) ToSlice() []T {
+	return s.m.Keys()
+}
+
+// String returns a string representation of the set from first to last
+func (s *OrderedSet[T]) String() string {
+	if s.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for item := range s.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v", item))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
@@ -0,0 +1,355 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"errors"
+	"iter"
+	"math"
+	"math/bits"
+)
+
+// BloomFilter represents a probabilistic set that never reports a false negative
+// and reports false positives at roughly the rate it was sized for.
+// Elements are hashed once and the k probe positions derived by double hashing.
+type BloomFilter[T any] struct {
+	probes[T]
+	bits []uint64
+}
+
+// NewBloomFilter creates a Bloom filter sized for n elements at false-positive rate p,
+// hashing elements with the package's default hash. Strings, booleans and numbers
+// hash the same way in every process. Other element types hash with a hash/maphash
+// seed chosen per process, so their filters cannot be reloaded by another process:
+// UnmarshalBinary reports an error instead.
+// It panics if n is not positive or p is not strictly between 0 and 1.
+func NewBloomFilter[T comparable](n int, p float64) *BloomFilter[T] {
+	m, k := optimalSize(n, p)
+	return &BloomFilter[T]{
+		probes: probes[T]{m: m, k: k, hasher: newHasher[T]()},
+		bits:   make([]uint64, (m+63)/64),
+	}
+}
+
+// NewBloomFilterFunc creates a Bloom filter sized for n elements at false-positive rate p,
+// hashing elements with hash. A hash that is stable across processes lets serialized
+// filters be reloaded elsewhere. Filters are only combined or reloaded by filters
+// with the same hash, which the caller must ensure.
+// It panics if n is not positive or p is not strictly between 0 and 1.
+func NewBloomFilterFunc[T any](n int, p float64, hash func(T) uint64) *BloomFilter[T] {
+	m, k := optimalSize(n, p)
+	return &BloomFilter[T]{
+		probes: probes[T]{m: m, k: k, hasher: newFuncHasher(hash)},
+		bits:   make([]uint64, (m+63)/64),
+	}
+}
+
+// Add inserts item into the filter
+func (f *BloomFilter[T]) Add(item T) {
+	for i := range f.positions(item) {
+		f.bits[i/64] |= 1 << (i % 64)
+	}
+}
+
+// Contains returns false if item was definitely never added, and true if it probably was
+func (f *BloomFilter[T]) Contains(item T) bool {
+	for i := range f.positions(item) {
+		if f.bits[i/64]&(1<<(i%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// EstimatedCount estimates the number of distinct elements added from the fraction of set bits
+func (f *BloomFilter[T]) EstimatedCount() int {
+	set := 0
+	for _, word := range f.bits {
+		set += bits.OnesCount64(word)
+	}
+	return f.estimate(set)
+}
+
+// Seed rehashes the filter with seed and clears it, so the filter is reproducible
+// and independent of filters with other seeds
+func (f *BloomFilter[T]) Seed(seed uint64) {
+	f.seed = seed
+	f.Clear()
+}
+
+// Union adds every element of other to f. Both filters must have the same size,
+// hash count, hash and seed.
+func (f *BloomFilter[T]) Union(other *BloomFilter[T]) error {
+	if !f.compatible(other.probes) {
+		return errors.New("filters are not compatible")
+	}
+	for i, word := range other.bits {
+		f.bits[i] |= word
+	}
+	return nil
+}
+
+// Intersect keeps only the bits set in both f and other, approximating the intersection.
+// Both filters must have the same size, hash count, hash and seed.
+func (f *BloomFilter[T]) Intersect(other *BloomFilter[T]) error {
+	if !f.compatible(other.probes) {
+		return errors.New("filters are not compatible")
+	}
+	for i, word := range other.bits {
+		f.bits[i] &= word
+	}
+	return nil
+}
+
+// Clear removes all elements from the filter
+func (f *BloomFilter[T]) Clear() {
+	clear(f.bits)
+}
+
+// MarshalBinary encodes the filter's size, hash count, hash identity and bits
+func (f *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	data := f.header(8 * len(f.bits))
+	for _, word := range f.bits {
+		data = binary.LittleEndian.AppendUint64(data, word)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary replaces the filter's contents and seed with data produced by
+// MarshalBinary. It returns an error if the data was encoded with a different hash.
+// Filters with caller-supplied hashes keep their own hash, which must match the encoder's.
+func (f *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	m, k, seed, body, err := f.readHeader(data)
+	if err != nil {
+		return err
+	}
+	// Check the length before allocating so a corrupt size cannot force a huge allocation
+	n := (m-1)/64 + 1
+	if len(body)%8 != 0 || len(body)/8 != n {
+		return errors.New("invalid filter encoding")
+	}
+	words := make([]uint64, n)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(body[8*i:])
+	}
+	f.m, f.k, f.seed, f.bits = m, k, seed, words
+	return nil
+}
+
+// CountingBloomFilter represents a Bloom filter with a small counter per position,
+// which lets elements be removed again. Counters saturate at 255 and then stay put.
+type CountingBloomFilter[T any] struct {
+	probes[T]
+	counters []uint8
+}
+
+// NewCountingBloomFilter creates a counting Bloom filter sized for n elements at
+// false-positive rate p, hashing elements with the package's default hash.
+// As with NewBloomFilter, filters of element types other than strings, booleans
+// and numbers cannot be reloaded by another process.
+// It panics if n is not positive or p is not strictly between 0 and 1.
+func NewCountingBloomFilter[T comparable](n int, p float64) *CountingBloomFilter[T] {
+	m, k := optimalSize(n, p)
+	return &CountingBloomFilter[T]{
+		probes:   probes[T]{m: m, k: k, hasher: newHasher[T]()},
+		counters: make([]uint8, m),
+	}
+}
+
+// NewCountingBloomFilterFunc creates a counting Bloom filter sized for n elements at
+// false-positive rate p, hashing elements with hash. Filters are only combined or
+// reloaded by filters with the same hash, which the caller must ensure.
+// It panics if n is not positive or p is not strictly between 0 and 1.
+func NewCountingBloomFilterFunc[T any](n int, p float64, hash func(T) uint64) *CountingBloomFilter[T] {
+	m, k := optimalSize(n, p)
+	return &CountingBloomFilter[T]{
+		probes:   probes[T]{m: m, k: k, hasher: newFuncHasher(hash)},
+		counters: make([]uint8, m),
+	}
+}
+
+// Add inserts one occurrence of item into the filter
+func (f *CountingBloomFilter[T]) Add(item T) {
+	for i := range f.positions(item) {
+		if f.counters[i] < math.MaxUint8 {
+			f.counters[i]++
+		}
+	}
+}
+
+// Remove deletes one occurrence of item and returns true if it was probably present.
+// Removing an item that was never added can introduce false negatives, so the
+// filter leaves its counters alone when item is definitely absent.
+func (f *CountingBloomFilter[T]) Remove(item T) bool {
+	if !f.Contains(item) {
+		return false
+	}
+	for i := range f.positions(item) {
+		// A saturated counter has lost track of its true count
+		if f.counters[i] < math.MaxUint8 {
+			f.counters[i]--
+		}
+	}
+	return true
+}
+
+// Contains returns false if item is definitely absent, and true if it is probably present
+func (f *CountingBloomFilter[T]) Contains(item T) bool {
+	for i := range f.positions(item) {
+		if f.counters[i] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// EstimatedCount estimates the number of distinct elements present from the fraction of non-zero counters
+func (f *CountingBloomFilter[T]) EstimatedCount() int {
+	set := 0
+	for _, c := range f.counters {
+		if c > 0 {
+			set++
+		}
+	}
+	return f.estimate(set)
+}
+
+// Seed rehashes the filter with seed and clears it, so the filter is reproducible
+// and independent of filters with other seeds
+func (f *CountingBloomFilter[T]) Seed(seed uint64) {
+	f.seed = seed
+	f.Clear()
+}
+
+// Union adds the counters of other to f. Both filters must have the same size,
+// hash count, hash and seed.
+func (f *CountingBloomFilter[T]) Union(other *CountingBloomFilter[T]) error {
+	if !f.compatible(other.probes) {
+		return errors.New("filters are not compatible")
+	}
+	for i, c := range other.counters {
+		f.counters[i] = uint8(min(int(f.counters[i])+int(c), math.MaxUint8))
+	}
+	return nil
+}
+
+// Intersect keeps the smaller of each pair of counters in f and other.
+// Both filters must have the same size, hash count, hash and seed.
+func (f *CountingBloomFilter[T]) Intersect(other *CountingBloomFilter[T]) error {
+	if !f.compatible(other.probes) {
+		return errors.New("filters are not compatible")
+	}
+	for i, c := range other.counters {
+		f.counters[i] = min(f.counters[i], c)
+	}
+	return nil
+}
+
+// Clear removes all elements from the filter
+func (f *CountingBloomFilter[T]) Clear() {
+	clear(f.counters)
+}
+
+// MarshalBinary encodes the filter's size, hash count, hash identity and counters
+func (f *CountingBloomFilter[T]) MarshalBinary() ([]byte, error) {
+	return append(f.header(len(f.counters)), f.counters...), nil
+}
+
+// UnmarshalBinary replaces the filter's contents and seed with data produced by
+// MarshalBinary. It returns an error if the data was encoded with a different hash.
+// Filters with caller-supplied hashes keep their own hash, which must match the encoder's.
+func (f *CountingBloomFilter[T]) UnmarshalBinary(data []byte) error {
+	m, k, seed, body, err := f.readHeader(data)
+	if err != nil {
+		return err
+	}
+	if len(body) != m {
+		return errors.New("invalid filter encoding")
+	}
+	f.m, f.k, f.seed, f.counters = m, k, seed, append([]uint8(nil), body...)
+	return nil
+}
+
+// probes holds the geometry and hasher shared by the Bloom filter variants
+type probes[T any] struct {
+	m int
+	k int
+	hasher[T]
+}
+
+// Size returns the number of positions in the filter
+func (p *probes[T]) Size() int {
+	return p.m
+}
+
+// HashCount returns the number of positions probed per element
+func (p *probes[T]) HashCount() int {
+	return p.k
+}
+
+// positions yields the k probe positions of item using Kirsch-Mitzenmacher double hashing
+func (p *probes[T]) positions(item T) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		h := p.sum(item)
+		h1, h2 := h&math.MaxUint32, h>>32|1
+		for i := range uint64(p.k) {
+			if !yield(int((h1 + i*h2) % uint64(p.m))) {
+				return
+			}
+		}
+	}
+}
+
+// estimate approximates the number of elements that set the given number of positions
+func (p *probes[T]) estimate(set int) int {
+	if set >= p.m {
+		return math.MaxInt
+	}
+	n := -float64(p.m) / float64(p.k) * math.Log(1-float64(set)/float64(p.m))
+	return int(math.Round(n))
+}
+
+// compatible reports whether two filters share the same geometry and hash
+func (p *probes[T]) compatible(other probes[T]) bool {
+	return p.m == other.m && p.k == other.k && p.sameHash(&other.hasher)
+}
+
+// header encodes the geometry and hash identity followed by room for a body of the given length
+func (p *probes[T]) header(body int) []byte {
+	data := make([]byte, 0, 32+body)
+	data = binary.LittleEndian.AppendUint64(data, uint64(p.m))
+	data = binary.LittleEndian.AppendUint64(data, uint64(p.k))
+	return p.appendIdentity(data)
+}
+
+// readHeader decodes the geometry and seed at the start of data and returns them
+// with the rest of data. It fails if data was encoded with a different hash.
+func (p *probes[T]) readHeader(data []byte) (int, int, uint64, []byte, error) {
+	if len(data) < 16 {
+		return 0, 0, 0, nil, errors.New("invalid filter encoding")
+	}
+	m := binary.LittleEndian.Uint64(data)
+	k := binary.LittleEndian.Uint64(data[8:])
+	// Any size a constructor can produce must decode; the callers check the body length
+	if m == 0 || k == 0 || m > math.MaxInt || k > math.MaxInt32 {
+		return 0, 0, 0, nil, errors.New("invalid filter encoding")
+	}
+	seed, body, err := p.readIdentity(data[16:])
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+	return int(m), int(k), seed, body, nil
+}
+
+// optimalSize returns the number of positions and probes that give false-positive
+// rate p for n elements. It panics on invalid parameters.
+func optimalSize(n int, p float64) (int, int) {
+	if n <= 0 {
+		panic("probabilistic: expected count must be positive")
+	}
+	if p <= 0 || p >= 1 {
+		panic("probabilistic: false-positive rate must be between 0 and 1")
+	}
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	k := math.Round(m / float64(n) * math.Ln2)
+	return int(m), max(int(k), 1)
+}
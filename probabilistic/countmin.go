@@ -0,0 +1,291 @@
+package probabilistic
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/abhishekR-tech/collections/linear"
+)
+
+// CountMinSketch represents an approximate frequency table in fixed memory.
+// Estimates never fall below the true count and exceed it by at most
+// epsilon times the total count with probability 1-delta.
+type CountMinSketch[T any] struct {
+	width  int
+	depth  int
+	counts []uint64
+	total  uint64
+	hasher[T]
+}
+
+// NewCountMinSketch creates a count-min sketch with error bound epsilon and failure
+// probability delta, hashing elements with the package's default hash. Sketches of
+// strings, booleans and numbers give the same estimates in every process.
+// It panics if epsilon or delta is not strictly between 0 and 1.
+func NewCountMinSketch[T comparable](epsilon, delta float64) *CountMinSketch[T] {
+	return newCountMinSketch(epsilon, delta, newHasher[T]())
+}
+
+// NewCountMinSketchFunc creates a count-min sketch with error bound epsilon and failure
+// probability delta, hashing elements with hash. Sketches are only merged with
+// sketches that use the same hash, which the caller must ensure.
+// It panics if epsilon or delta is not strictly between 0 and 1.
+func NewCountMinSketchFunc[T any](epsilon, delta float64, hash func(T) uint64) *CountMinSketch[T] {
+	return newCountMinSketch(epsilon, delta, newFuncHasher(hash))
+}
+
+// newCountMinSketch creates a count-min sketch that hashes elements with h
+func newCountMinSketch[T any](epsilon, delta float64, h hasher[T]) *CountMinSketch[T] {
+	if epsilon <= 0 || epsilon >= 1 {
+		panic("probabilistic: error bound must be between 0 and 1")
+	}
+	if delta <= 0 || delta >= 1 {
+		panic("probabilistic: failure probability must be between 0 and 1")
+	}
+	width := int(math.Ceil(math.E / epsilon))
+	depth := int(math.Ceil(math.Log(1 / delta)))
+	return &CountMinSketch[T]{
+		width:  width,
+		depth:  depth,
+		counts: make([]uint64, width*depth),
+		hasher: h,
+	}
+}
+
+// Add records count occurrences of item
+func (s *CountMinSketch[T]) Add(item T, count uint64) {
+	h1, h2 := s.rowHashes(item)
+	for row := range s.depth {
+		s.counts[s.cell(row, h1, h2)] += count
+	}
+	s.total += count
+}
+
+// Count returns an estimate of the number of occurrences of item that is never too low
+func (s *CountMinSketch[T]) Count(item T) uint64 {
+	h1, h2 := s.rowHashes(item)
+	estimate := uint64(math.MaxUint64)
+	for row := range s.depth {
+		estimate = min(estimate, s.counts[s.cell(row, h1, h2)])
+	}
+	return estimate
+}
+
+// Total returns the sum of all counts added
+func (s *CountMinSketch[T]) Total() uint64 {
+	return s.total
+}
+
+// Width returns the number of counters per row
+func (s *CountMinSketch[T]) Width() int {
+	return s.width
+}
+
+// Depth returns the number of rows, one per hash function
+func (s *CountMinSketch[T]) Depth() int {
+	return s.depth
+}
+
+// Seed rehashes the sketch with seed and clears it, so its estimates are reproducible
+// and independent of sketches with other seeds
+func (s *CountMinSketch[T]) Seed(seed uint64) {
+	s.seed = seed
+	s.Clear()
+}
+
+// Merge adds the counts of other to s. Both sketches must have the same
+// dimensions, hash and seed.
+func (s *CountMinSketch[T]) Merge(other *CountMinSketch[T]) error {
+	if s.width != other.width || s.depth != other.depth || !s.sameHash(&other.hasher) {
+		return errors.New("sketches are not compatible")
+	}
+	for i, c := range other.counts {
+		s.counts[i] += c
+	}
+	s.total += other.total
+	return nil
+}
+
+// Clear resets every counter to zero
+func (s *CountMinSketch[T]) Clear() {
+	clear(s.counts)
+	s.total = 0
+}
+
+// rowHashes splits the hash of item into the two halves used for double hashing
+func (s *CountMinSketch[T]) rowHashes(item T) (uint64, uint64) {
+	h := s.sum(item)
+	return h & math.MaxUint32, h>>32 | 1
+}
+
+// cell returns the index of item's counter in the given row
+func (s *CountMinSketch[T]) cell(row int, h1, h2 uint64) int {
+	return row*s.width + int((h1+uint64(row)*h2)%uint64(s.width))
+}
+
+// FrequentItem pairs a value with its estimated count
+type FrequentItem[T any] struct {
+	Value T
+	Count uint64
+}
+
+// HeavyHitters tracks the k most frequent items of a stream using a count-min sketch
+// for the estimates and a min-heap of the current candidates.
+type HeavyHitters[T comparable] struct {
+	k          int
+	sketch     *CountMinSketch[T]
+	candidates map[T]candidate[T]
+	admitted   uint64
+	// heap may hold stale entries for items whose estimate has since grown or
+	// that were evicted; they are discarded when they reach the front
+	heap *linear.PriorityQueue[candidate[T]]
+}
+
+// candidate records a tracked item, its estimate when last updated and the order
+// in which it was admitted, which breaks ties between equal estimates
+type candidate[T any] struct {
+	value    T
+	count    uint64
+	admitted uint64
+}
+
+// NewHeavyHitters creates a tracker for the k most frequent items, backed by a
+// count-min sketch with error bound epsilon and failure probability delta that
+// uses the package's default hash.
+// It panics if k is not positive or epsilon or delta is not strictly between 0 and 1.
+func NewHeavyHitters[T comparable](k int, epsilon, delta float64) *HeavyHitters[T] {
+	return newHeavyHitters(k, NewCountMinSketch[T](epsilon, delta))
+}
+
+// NewHeavyHittersFunc creates a tracker for the k most frequent items, backed by a
+// count-min sketch that hashes elements with hash.
+// It panics if k is not positive or epsilon or delta is not strictly between 0 and 1.
+func NewHeavyHittersFunc[T comparable](k int, epsilon, delta float64, hash func(T) uint64) *HeavyHitters[T] {
+	return newHeavyHitters(k, NewCountMinSketchFunc(epsilon, delta, hash))
+}
+
+// newHeavyHitters creates a tracker for the k most frequent items backed by sketch
+func newHeavyHitters[T comparable](k int, sketch *CountMinSketch[T]) *HeavyHitters[T] {
+	if k <= 0 {
+		panic("probabilistic: k must be positive")
+	}
+	return &HeavyHitters[T]{
+		k:          k,
+		sketch:     sketch,
+		candidates: make(map[T]candidate[T], k),
+		heap:       linear.NewPriorityQueue(lessFrequent[T]),
+	}
+}
+
+// Add records count occurrences of item and updates the candidate set
+func (h *HeavyHitters[T]) Add(item T, count uint64) {
+	if count == 0 {
+		return
+	}
+	h.sketch.Add(item, count)
+	estimate := h.sketch.Count(item)
+
+	c, ok := h.candidates[item]
+	if !ok {
+		if len(h.candidates) >= h.k {
+			smallest := h.smallest()
+			if estimate <= smallest.count {
+				return
+			}
+			h.heap.Pop()
+			delete(h.candidates, smallest.value)
+		}
+		c = candidate[T]{value: item, admitted: h.admitted}
+		h.admitted++
+	}
+	c.count = estimate
+	h.candidates[item] = c
+	h.heap.Push(c)
+
+	if h.heap.Size() > 4*h.k {
+		h.compact()
+	}
+}
+
+// Count returns the sketch's estimate for item, whether or not it is a candidate
+func (h *HeavyHitters[T]) Count(item T) uint64 {
+	return h.sketch.Count(item)
+}
+
+// Total returns the sum of all counts added
+func (h *HeavyHitters[T]) Total() uint64 {
+	return h.sketch.Total()
+}
+
+// K returns the maximum number of items tracked
+func (h *HeavyHitters[T]) K() int {
+	return h.k
+}
+
+// Top returns the tracked items with their current estimates, ordered from most to
+// least frequent. Items with equal estimates appear in the order they were admitted.
+func (h *HeavyHitters[T]) Top() []FrequentItem[T] {
+	tracked := make([]candidate[T], 0, len(h.candidates))
+	for _, c := range h.candidates {
+		c.count = h.sketch.Count(c.value)
+		tracked = append(tracked, c)
+	}
+	slices.SortFunc(tracked, func(a, b candidate[T]) int {
+		if c := cmp.Compare(b.count, a.count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.admitted, b.admitted)
+	})
+
+	result := make([]FrequentItem[T], len(tracked))
+	for i, c := range tracked {
+		result[i] = FrequentItem[T]{Value: c.value, Count: c.count}
+	}
+	return result
+}
+
+// Seed rehashes the underlying sketch with seed and clears the tracker,
+// so its estimates are reproducible
+func (h *HeavyHitters[T]) Seed(seed uint64) {
+	h.sketch.Seed(seed)
+	h.Clear()
+}
+
+// Clear resets the sketch and forgets every candidate
+func (h *HeavyHitters[T]) Clear() {
+	h.sketch.Clear()
+	clear(h.candidates)
+	h.admitted = 0
+	h.heap.Clear()
+}
+
+// smallest discards stale entries and returns the candidate with the lowest estimate
+func (h *HeavyHitters[T]) smallest() candidate[T] {
+	for {
+		top, _ := h.heap.Peek()
+		if live, ok := h.candidates[top.value]; ok && live == top {
+			return top
+		}
+		h.heap.Pop()
+	}
+}
+
+// compact rebuilds the heap from the live candidates, dropping stale entries
+func (h *HeavyHitters[T]) compact() {
+	live := make([]candidate[T], 0, len(h.candidates))
+	for _, c := range h.candidates {
+		live = append(live, c)
+	}
+	h.heap = linear.FromPriorityQueueSlice(live, lessFrequent[T])
+}
+
+// lessFrequent puts the candidate with the lowest estimate at the front of a heap,
+// preferring the most recently admitted among equal estimates
+func lessFrequent[T any](a, b candidate[T]) bool {
+	if a.count != b.count {
+		return a.count < b.count
+	}
+	return a.admitted > b.admitted
+}
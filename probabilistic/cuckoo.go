@@ -0,0 +1,354 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+)
+
+// maxKicks bounds the chain of relocations tried before an insert gives up
+const maxKicks = 500
+
+// ErrFilterFull is returned by CuckooFilter.Insert when no free slot can be found
+var ErrFilterFull = errors.New("filter is full")
+
+// CuckooFilter represents a probabilistic set that supports deletion.
+// Each element is stored as a short fingerprint in one of two candidate buckets;
+// when both are full, resident fingerprints are relocated to their other bucket.
+// Fingerprints are bit-packed, so a filter costs roughly fingerprintBits per slot.
+type CuckooFilter[T any] struct {
+	fingerprintBits int
+	bucketSize      int
+	mask            uint64
+	table           []uint64
+	count           int
+	hasher[T]
+	rng *rand.Rand
+}
+
+// NewCuckooFilter creates a cuckoo filter with room for about n elements, storing
+// fingerprints of the given number of bits in buckets of the given size and hashing
+// elements with the package's default hash. The false-positive rate is roughly
+// 2*bucketSize/2^fingerprintBits. As with NewBloomFilter, filters of element types
+// other than strings, booleans and numbers cannot be reloaded by another process.
+// It panics if n is not positive, fingerprintBits is not between 4 and 32
+// or bucketSize is not between 1 and 8.
+func NewCuckooFilter[T comparable](n, fingerprintBits, bucketSize int) *CuckooFilter[T] {
+	return newCuckooFilter(n, fingerprintBits, bucketSize, newHasher[T]())
+}
+
+// NewCuckooFilterFunc creates a cuckoo filter with room for about n elements, storing
+// fingerprints of the given number of bits in buckets of the given size and hashing
+// elements with hash. A hash that is stable across processes lets serialized filters
+// be reloaded elsewhere; matching the encoder's hash is up to the caller.
+// It panics if n is not positive, fingerprintBits is not between 4 and 32
+// or bucketSize is not between 1 and 8.
+func NewCuckooFilterFunc[T any](n, fingerprintBits, bucketSize int, hash func(T) uint64) *CuckooFilter[T] {
+	return newCuckooFilter(n, fingerprintBits, bucketSize, newFuncHasher(hash))
+}
+
+// newCuckooFilter creates a cuckoo filter with room for about n elements that hashes elements with h
+func newCuckooFilter[T any](n, fingerprintBits, bucketSize int, h hasher[T]) *CuckooFilter[T] {
+	if n <= 0 {
+		panic("probabilistic: expected count must be positive")
+	}
+	if fingerprintBits < 4 || fingerprintBits > 32 {
+		panic("probabilistic: fingerprint size must be between 4 and 32 bits")
+	}
+	if bucketSize < 1 || bucketSize > 8 {
+		panic("probabilistic: bucket size must be between 1 and 8")
+	}
+
+	// Size the table so n elements stay below the load at which inserts start to fail
+	slots := math.Ceil(float64(n) / maxLoad(bucketSize))
+	buckets := uint64(1) << bits.Len64(uint64(math.Ceil(slots/float64(bucketSize)))-1)
+	f := &CuckooFilter[T]{
+		fingerprintBits: fingerprintBits,
+		bucketSize:      bucketSize,
+		mask:            buckets - 1,
+		hasher:          h,
+		rng:             rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+	}
+	f.table = make([]uint64, f.tableWords())
+	return f
+}
+
+// Seed rehashes the filter with seed, resets the random generator that picks
+// relocation victims and clears the filter, so inserts are reproducible
+func (f *CuckooFilter[T]) Seed(seed uint64) {
+	f.seed = seed
+	f.rng = rand.New(rand.NewPCG(seed, seed))
+	f.Clear()
+}
+
+// Insert adds item to the filter. It returns ErrFilterFull and leaves the filter
+// unchanged if no free slot could be found for it.
+func (f *CuckooFilter[T]) Insert(item T) error {
+	fp, i1 := f.locate(item)
+	i2 := f.altIndex(i1, fp)
+	if f.place(i1, fp) || f.place(i2, fp) {
+		f.count++
+		return nil
+	}
+
+	// Evict random residents along a chain, remembering each slot so the
+	// chain can be unwound if it never reaches a free slot
+	path := make([]int, 0, maxKicks)
+	i := i1
+	if f.rng.IntN(2) == 1 {
+		i = i2
+	}
+	for range maxKicks {
+		s := int(i)*f.bucketSize + f.rng.IntN(f.bucketSize)
+		path = append(path, s)
+		// The evicted fingerprint lived in bucket i, so its other bucket is the next stop
+		fp = f.swap(s, fp)
+		i = f.altIndex(i, fp)
+		if f.place(i, fp) {
+			f.count++
+			return nil
+		}
+	}
+	for j := len(path) - 1; j >= 0; j-- {
+		fp = f.swap(path[j], fp)
+	}
+	return ErrFilterFull
+}
+
+// Lookup returns false if item is definitely absent, and true if it is probably present
+func (f *CuckooFilter[T]) Lookup(item T) bool {
+	fp, i1 := f.locate(item)
+	return f.find(i1, fp) >= 0 || f.find(f.altIndex(i1, fp), fp) >= 0
+}
+
+// Delete removes one copy of item's fingerprint and returns true if one was found.
+// Deleting an item that was never inserted may remove a different item that
+// shares its fingerprint.
+func (f *CuckooFilter[T]) Delete(item T) bool {
+	fp, i1 := f.locate(item)
+	s := f.find(i1, fp)
+	if s < 0 {
+		s = f.find(f.altIndex(i1, fp), fp)
+	}
+	if s < 0 {
+		return false
+	}
+	f.set(s, 0)
+	f.count--
+	return true
+}
+
+// Count returns how many copies of item's fingerprint are stored in its two buckets.
+// This is the number of times item was inserted and not deleted, plus any other
+// items that share its fingerprint and buckets.
+func (f *CuckooFilter[T]) Count(item T) int {
+	fp, i1 := f.locate(item)
+	count := f.countIn(i1, fp)
+	if i2 := f.altIndex(i1, fp); i2 != i1 {
+		count += f.countIn(i2, fp)
+	}
+	return count
+}
+
+// Size returns the number of fingerprints stored in the filter
+func (f *CuckooFilter[T]) Size() int {
+	return f.count
+}
+
+// Capacity returns the number of fingerprint slots in the filter
+func (f *CuckooFilter[T]) Capacity() int {
+	return f.buckets() * f.bucketSize
+}
+
+// LoadFactor returns the fraction of slots in use
+func (f *CuckooFilter[T]) LoadFactor() float64 {
+	return float64(f.count) / float64(f.Capacity())
+}
+
+// FingerprintBits returns the number of bits stored per element
+func (f *CuckooFilter[T]) FingerprintBits() int {
+	return f.fingerprintBits
+}
+
+// BucketSize returns the number of slots per bucket
+func (f *CuckooFilter[T]) BucketSize() int {
+	return f.bucketSize
+}
+
+// IsEmpty returns true if the filter holds no fingerprints
+func (f *CuckooFilter[T]) IsEmpty() bool {
+	return f.count == 0
+}
+
+// Clear removes all elements from the filter
+func (f *CuckooFilter[T]) Clear() {
+	clear(f.table)
+	f.count = 0
+}
+
+// MarshalBinary encodes the filter's geometry, hash identity and packed fingerprints
+func (f *CuckooFilter[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 26+8*len(f.table))
+	data = binary.LittleEndian.AppendUint64(data, uint64(f.buckets()))
+	data = append(data, uint8(f.fingerprintBits), uint8(f.bucketSize))
+	data = f.appendIdentity(data)
+	for _, word := range f.table {
+		data = binary.LittleEndian.AppendUint64(data, word)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary replaces the filter's contents and seed with data produced by
+// MarshalBinary. It returns an error if the data was encoded with a different hash.
+// Filters with caller-supplied hashes keep their own hash, which must match the encoder's.
+func (f *CuckooFilter[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < 10 {
+		return errors.New("invalid filter encoding")
+	}
+	buckets := binary.LittleEndian.Uint64(data)
+	fingerprintBits, bucketSize := int(data[8]), int(data[9])
+	if buckets == 0 || buckets&(buckets-1) != 0 || buckets > 1<<32 ||
+		fingerprintBits < 4 || fingerprintBits > 32 || bucketSize < 1 || bucketSize > 8 {
+		return errors.New("invalid filter encoding")
+	}
+
+	decoded := &CuckooFilter[T]{
+		fingerprintBits: fingerprintBits,
+		bucketSize:      bucketSize,
+		mask:            buckets - 1,
+	}
+	seed, body, err := f.readIdentity(data[10:])
+	if err != nil {
+		return err
+	}
+	if len(body) != 8*decoded.tableWords() {
+		return errors.New("invalid filter encoding")
+	}
+	decoded.table = make([]uint64, decoded.tableWords())
+	for i := range decoded.table {
+		decoded.table[i] = binary.LittleEndian.Uint64(body[8*i:])
+	}
+	for s := range decoded.Capacity() {
+		if decoded.get(s) != 0 {
+			decoded.count++
+		}
+	}
+
+	f.fingerprintBits, f.bucketSize, f.mask = decoded.fingerprintBits, decoded.bucketSize, decoded.mask
+	f.table, f.count, f.seed = decoded.table, decoded.count, seed
+	return nil
+}
+
+// locate returns item's non-zero fingerprint and its primary bucket
+func (f *CuckooFilter[T]) locate(item T) (uint32, uint64) {
+	h := f.sum(item)
+	// The fingerprint comes from the high bits and the bucket from the low bits
+	fp := uint32(h >> (64 - f.fingerprintBits))
+	if fp == 0 {
+		// Zero marks an empty slot
+		fp = 1
+	}
+	return fp, h & f.mask
+}
+
+// altIndex returns the other bucket for a fingerprint stored in bucket i.
+// Applying it twice gives back i, so a fingerprint can move between its buckets
+// without knowing the element it came from.
+func (f *CuckooFilter[T]) altIndex(i uint64, fp uint32) uint64 {
+	return (i ^ mix64(uint64(fp))) & f.mask
+}
+
+// place stores fp in a free slot of bucket i and returns true on success
+func (f *CuckooFilter[T]) place(i uint64, fp uint32) bool {
+	s := f.find(i, 0)
+	if s < 0 {
+		return false
+	}
+	f.set(s, fp)
+	return true
+}
+
+// find returns the first slot of bucket i that holds fp, or -1 if there is none
+func (f *CuckooFilter[T]) find(i uint64, fp uint32) int {
+	start := int(i) * f.bucketSize
+	for s := start; s < start+f.bucketSize; s++ {
+		if f.get(s) == fp {
+			return s
+		}
+	}
+	return -1
+}
+
+// countIn returns the number of slots of bucket i that hold fp
+func (f *CuckooFilter[T]) countIn(i uint64, fp uint32) int {
+	count := 0
+	start := int(i) * f.bucketSize
+	for s := start; s < start+f.bucketSize; s++ {
+		if f.get(s) == fp {
+			count++
+		}
+	}
+	return count
+}
+
+// swap stores fp in slot s and returns the fingerprint it replaced
+func (f *CuckooFilter[T]) swap(s int, fp uint32) uint32 {
+	old := f.get(s)
+	f.set(s, fp)
+	return old
+}
+
+// get returns the fingerprint packed into slot s
+func (f *CuckooFilter[T]) get(s int) uint32 {
+	bit := s * f.fingerprintBits
+	w, off := bit/64, bit%64
+	v := f.table[w] >> off
+	if off+f.fingerprintBits > 64 {
+		v |= f.table[w+1] << (64 - off)
+	}
+	return uint32(v & f.fingerprintMask())
+}
+
+// set packs fp into slot s
+func (f *CuckooFilter[T]) set(s int, fp uint32) {
+	bit := s * f.fingerprintBits
+	w, off := bit/64, bit%64
+	mask := f.fingerprintMask()
+	f.table[w] = f.table[w]&^(mask<<off) | uint64(fp)<<off
+	if off+f.fingerprintBits > 64 {
+		rest := 64 - off
+		f.table[w+1] = f.table[w+1]&^(mask>>rest) | uint64(fp)>>rest
+	}
+}
+
+// fingerprintMask returns a mask covering the low fingerprintBits bits
+func (f *CuckooFilter[T]) fingerprintMask() uint64 {
+	return 1<<f.fingerprintBits - 1
+}
+
+// buckets returns the number of buckets in the filter
+func (f *CuckooFilter[T]) buckets() int {
+	return int(f.mask) + 1
+}
+
+// tableWords returns the number of words needed to pack every slot
+func (f *CuckooFilter[T]) tableWords() int {
+	return (f.Capacity()*f.fingerprintBits + 63) / 64
+}
+
+// maxLoad returns the fraction of slots that can typically be filled before
+// inserts fail, which grows with the number of slots per bucket
+func maxLoad(bucketSize int) float64 {
+	switch bucketSize {
+	case 1:
+		return 0.5
+	case 2:
+		return 0.84
+	case 3:
+		return 0.9
+	default:
+		return 0.95
+	}
+}
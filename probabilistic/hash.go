@@ -0,0 +1,156 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/maphash"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Tokens identifying the base hash of a hasher
+const (
+	// customHash marks a caller-supplied hash, which the package cannot tell apart
+	customHash uint64 = 0
+	// portableHash marks the built-in hashes of strings, booleans and numbers,
+	// which give the same result in every process
+	portableHash uint64 = 1
+)
+
+// processSeed hashes element types without a portable encoding. It is shared by
+// the whole process so that structures built the same way hash the same way.
+var processSeed = maphash.MakeSeed()
+
+// processToken identifies hashes built on processSeed. Setting bit 1 keeps it
+// distinct from customHash and portableHash.
+var processToken = maphash.String(processSeed, "probabilistic") | 2
+
+// hasher hashes elements with a base hash mixed with a seed. Its token identifies
+// the base hash, so structures that hash differently are never combined or decoded
+// into one another.
+type hasher[T any] struct {
+	base  func(T) uint64
+	token uint64
+	seed  uint64
+}
+
+// newHasher returns a hasher using the default hash for T
+func newHasher[T comparable]() hasher[T] {
+	base, token := defaultHash[T]()
+	return hasher[T]{base: base, token: token}
+}
+
+// newFuncHasher returns a hasher using a caller-supplied hash
+func newFuncHasher[T any](hash func(T) uint64) hasher[T] {
+	return hasher[T]{base: hash, token: customHash}
+}
+
+// sum returns the seeded hash of item
+func (h *hasher[T]) sum(item T) uint64 {
+	return mix64(mix64(h.base(item)) ^ h.seed)
+}
+
+// sameHash reports whether h and other hash every element identically
+func (h *hasher[T]) sameHash(other *hasher[T]) bool {
+	return h.token == other.token && h.seed == other.seed
+}
+
+// appendIdentity encodes the hash token and seed onto data
+func (h *hasher[T]) appendIdentity(data []byte) []byte {
+	data = binary.LittleEndian.AppendUint64(data, h.token)
+	return binary.LittleEndian.AppendUint64(data, h.seed)
+}
+
+// readIdentity decodes a hash identity from the start of data, checks that it
+// names the same base hash as h and returns the encoded seed with the rest of data
+func (h *hasher[T]) readIdentity(data []byte) (uint64, []byte, error) {
+	if len(data) < 16 {
+		return 0, nil, errors.New("invalid filter encoding")
+	}
+	if binary.LittleEndian.Uint64(data) != h.token {
+		return 0, nil, errors.New("filter was encoded with a different hash")
+	}
+	return binary.LittleEndian.Uint64(data[8:]), data[16:], nil
+}
+
+// defaultHash returns a hash for T and its token. Strings, booleans and numbers
+// hash portably; other types fall back to hash/maphash with the process seed.
+func defaultHash[T comparable]() (func(T) uint64, uint64) {
+	switch any(*new(T)).(type) {
+	case string:
+		return func(item T) uint64 { return hashString(any(item).(string)) }, portableHash
+	case bool:
+		return func(item T) uint64 {
+			if any(item).(bool) {
+				return 1
+			}
+			return 0
+		}, portableHash
+	case int:
+		return integerHash[T, int](), portableHash
+	case int8:
+		return integerHash[T, int8](), portableHash
+	case int16:
+		return integerHash[T, int16](), portableHash
+	case int32:
+		return integerHash[T, int32](), portableHash
+	case int64:
+		return integerHash[T, int64](), portableHash
+	case uint:
+		return integerHash[T, uint](), portableHash
+	case uint8:
+		return integerHash[T, uint8](), portableHash
+	case uint16:
+		return integerHash[T, uint16](), portableHash
+	case uint32:
+		return integerHash[T, uint32](), portableHash
+	case uint64:
+		return integerHash[T, uint64](), portableHash
+	case uintptr:
+		return integerHash[T, uintptr](), portableHash
+	case float32:
+		return func(item T) uint64 { return hashFloat(float64(any(item).(float32))) }, portableHash
+	case float64:
+		return func(item T) uint64 { return hashFloat(any(item).(float64)) }, portableHash
+	}
+	return func(item T) uint64 { return maphash.Comparable(processSeed, item) }, processToken
+}
+
+// integerHash returns a hash for a T whose dynamic type is the integer type I
+func integerHash[T comparable, I constraints.Integer]() func(T) uint64 {
+	return func(item T) uint64 {
+		return uint64(any(item).(I))
+	}
+}
+
+// hashFloat hashes f so that values comparing equal, such as 0 and -0, hash equally
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		f = 0
+	}
+	return math.Float64bits(f)
+}
+
+// hashString hashes s eight bytes at a time
+func hashString(s string) uint64 {
+	h := uint64(len(s))
+	for ; len(s) >= 8; s = s[8:] {
+		h = mix64(h ^ binary.LittleEndian.Uint64([]byte(s[:8])))
+	}
+	var tail uint64
+	for i := len(s) - 1; i >= 0; i-- {
+		tail = tail<<8 | uint64(s[i])
+	}
+	return mix64(h ^ tail)
+}
+
+// mix64 scrambles a hash with the splitmix64 finalizer so weak hashes still spread over all bits
+func mix64(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
@@ -0,0 +1,115 @@
+package probabilistic
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// HyperLogLog represents a cardinality estimator that counts distinct elements
+// in 2^precision bytes with a standard error of about 1.04/sqrt(2^precision).
+type HyperLogLog[T any] struct {
+	precision uint8
+	registers []uint8
+	hasher[T]
+}
+
+// NewHyperLogLog creates a HyperLogLog with 2^precision registers, hashing elements
+// with the package's default hash. Estimators of strings, booleans and numbers give
+// the same estimates in every process.
+// It panics if precision is not between 4 and 18.
+func NewHyperLogLog[T comparable](precision int) *HyperLogLog[T] {
+	return newHyperLogLog(precision, newHasher[T]())
+}
+
+// NewHyperLogLogFunc creates a HyperLogLog with 2^precision registers, hashing elements
+// with hash. Estimators are only merged with estimators that use the same hash, which
+// the caller must ensure.
+// It panics if precision is not between 4 and 18.
+func NewHyperLogLogFunc[T any](precision int, hash func(T) uint64) *HyperLogLog[T] {
+	return newHyperLogLog(precision, newFuncHasher(hash))
+}
+
+// newHyperLogLog creates a HyperLogLog that hashes elements with h
+func newHyperLogLog[T any](precision int, h hasher[T]) *HyperLogLog[T] {
+	if precision < 4 || precision > 18 {
+		panic("probabilistic: precision must be between 4 and 18")
+	}
+	return &HyperLogLog[T]{
+		precision: uint8(precision),
+		registers: make([]uint8, 1<<precision),
+		hasher:    h,
+	}
+}
+
+// Add records an occurrence of item
+func (h *HyperLogLog[T]) Add(item T) {
+	x := h.sum(item)
+	index := x >> (64 - h.precision)
+	// The sentinel bit caps the run of leading zeros for the remaining bits
+	rest := x<<h.precision | 1<<(h.precision-1)
+	rank := uint8(bits.LeadingZeros64(rest) + 1)
+	h.registers[index] = max(h.registers[index], rank)
+}
+
+// Count returns the estimated number of distinct elements added
+func (h *HyperLogLog[T]) Count() uint64 {
+	m := float64(len(h.registers))
+	sum, zeros := 0.0, 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := h.alpha() * m * m / sum
+
+	// Linear counting is more accurate while many registers are still empty
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(math.Round(estimate))
+}
+
+// Precision returns the base-2 logarithm of the number of registers
+func (h *HyperLogLog[T]) Precision() int {
+	return int(h.precision)
+}
+
+// Seed rehashes the estimator with seed and clears it, so its estimates are
+// reproducible and independent of estimators with other seeds
+func (h *HyperLogLog[T]) Seed(seed uint64) {
+	h.seed = seed
+	h.Clear()
+}
+
+// Merge folds the elements counted by other into h, so h estimates the size of
+// their union. Both estimators must have the same precision, hash and seed.
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) error {
+	if h.precision != other.precision || !h.sameHash(&other.hasher) {
+		return errors.New("estimators are not compatible")
+	}
+	for i, r := range other.registers {
+		h.registers[i] = max(h.registers[i], r)
+	}
+	return nil
+}
+
+// Clear resets the estimator to count zero elements
+func (h *HyperLogLog[T]) Clear() {
+	clear(h.registers)
+}
+
+// alpha returns the bias correction constant for the number of registers
+func (h *HyperLogLog[T]) alpha() float64 {
+	switch m := len(h.registers); m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/float64(m))
+	}
+}
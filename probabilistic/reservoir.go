@@ -0,0 +1,164 @@
+package probabilistic
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+
+	"github.com/abhishekR-tech/collections/linear"
+)
+
+// Reservoir keeps a uniform random sample of k items from a stream of unknown
+// length using Algorithm R. Every item seen so far is in the sample with
+// probability k/n.
+type Reservoir[T any] struct {
+	k     int
+	seen  int
+	items []T
+	rng   *rand.Rand
+}
+
+// NewReservoir creates a reservoir that samples k items.
+// It panics if k is not positive.
+func NewReservoir[T any](k int) *Reservoir[T] {
+	if k <= 0 {
+		panic("probabilistic: sample size must be positive")
+	}
+	return &Reservoir[T]{
+		k:     k,
+		items: make([]T, 0, k),
+		rng:   rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+	}
+}
+
+// Seed resets the random generator so the sample is reproducible
+func (r *Reservoir[T]) Seed(seed uint64) {
+	r.rng = rand.New(rand.NewPCG(seed, seed))
+}
+
+// Add offers the next item of the stream
+func (r *Reservoir[T]) Add(item T) {
+	r.seen++
+	if len(r.items) < r.k {
+		r.items = append(r.items, item)
+		return
+	}
+	if j := r.rng.IntN(r.seen); j < r.k {
+		r.items[j] = item
+	}
+}
+
+// Sample returns a copy of the sampled items
+func (r *Reservoir[T]) Sample() []T {
+	result := make([]T, len(r.items))
+	copy(result, r.items)
+	return result
+}
+
+// Seen returns the number of items offered so far
+func (r *Reservoir[T]) Seen() int {
+	return r.seen
+}
+
+// K returns the maximum number of items sampled
+func (r *Reservoir[T]) K() int {
+	return r.k
+}
+
+// Size returns the number of items currently sampled
+func (r *Reservoir[T]) Size() int {
+	return len(r.items)
+}
+
+// Clear discards the sample and the count of items seen
+func (r *Reservoir[T]) Clear() {
+	r.seen = 0
+	r.items = r.items[:0]
+}
+
+// weightedItem pairs a sampled item with its A-Res key
+type weightedItem[T any] struct {
+	item T
+	key  float64
+}
+
+// WeightedReservoir keeps a weighted random sample of k items without replacement
+// using the A-Res algorithm. Each item draws the key u^(1/w) and the k largest keys
+// are kept in a min-heap, so heavier items are proportionally more likely to survive.
+type WeightedReservoir[T any] struct {
+	k    int
+	seen int
+	heap *linear.PriorityQueue[weightedItem[T]]
+	rng  *rand.Rand
+}
+
+// NewWeightedReservoir creates a weighted reservoir that samples k items.
+// It panics if k is not positive.
+func NewWeightedReservoir[T any](k int) *WeightedReservoir[T] {
+	if k <= 0 {
+		panic("probabilistic: sample size must be positive")
+	}
+	return &WeightedReservoir[T]{
+		k: k,
+		heap: linear.NewPriorityQueue(func(a, b weightedItem[T]) bool {
+			return a.key < b.key
+		}),
+		rng: rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+	}
+}
+
+// Seed resets the random generator so the sample is reproducible
+func (r *WeightedReservoir[T]) Seed(seed uint64) {
+	r.rng = rand.New(rand.NewPCG(seed, seed))
+}
+
+// Add offers the next item of the stream with the given weight
+func (r *WeightedReservoir[T]) Add(item T, weight float64) error {
+	if !(weight > 0) || math.IsInf(weight, 1) {
+		return errors.New("weight must be positive and finite")
+	}
+	r.seen++
+
+	// Compare log(u)/w instead of u^(1/w), which underflows for small weights;
+	// 1-Float64 lies in (0, 1] so the logarithm is always finite
+	key := math.Log(1-r.rng.Float64()) / weight
+	if r.heap.Size() < r.k {
+		r.heap.Push(weightedItem[T]{item: item, key: key})
+		return nil
+	}
+	if smallest, _ := r.heap.Peek(); smallest.key < key {
+		r.heap.Replace(weightedItem[T]{item: item, key: key})
+	}
+	return nil
+}
+
+// Sample returns the sampled items
+func (r *WeightedReservoir[T]) Sample() []T {
+	entries := r.heap.ToSlice()
+	result := make([]T, len(entries))
+	for i, e := range entries {
+		result[i] = e.item
+	}
+	return result
+}
+
+// Seen returns the number of items offered so far
+func (r *WeightedReservoir[T]) Seen() int {
+	return r.seen
+}
+
+// K returns the maximum number of items sampled
+func (r *WeightedReservoir[T]) K() int {
+	return r.k
+}
+
+// Size returns the number of items currently sampled
+func (r *WeightedReservoir[T]) Size() int {
+	return r.heap.Size()
+}
+
+// Clear discards the sample and the count of items seen
+func (r *WeightedReservoir[T]) Clear() {
+	r.seen = 0
+	r.heap.Clear()
+}
@@ -0,0 +1,171 @@
+package rangequery
+
+import (
+	"errors"
+
+	"github.com/abhishekR-tech/collections/linear"
+)
+
+// CartesianTree represents the Cartesian tree of an array: a binary tree that
+// is heap-ordered by less and whose in-order traversal gives back the array.
+// Nodes are array indices. The minimum of a range is the lowest common ancestor
+// of its endpoints, which an Euler tour and a SparseTable answer in O(1).
+type CartesianTree[T any] struct {
+	items  []T
+	root   int
+	parent []int
+	left   []int
+	right  []int
+	// first[i] is the position of node i's first visit in the Euler tour
+	first []int
+	tour  *SparseTable[int]
+}
+
+// FromCartesianTreeSlice builds the Cartesian tree of a slice in O(n) with a stack,
+// then prepares it for O(1) range-minimum queries in O(n log n).
+// Among equal elements the leftmost becomes the ancestor.
+func FromCartesianTreeSlice[T any](slice []T, less func(a, b T) bool) *CartesianTree[T] {
+	n := len(slice)
+	ct := &CartesianTree[T]{
+		items:  append([]T(nil), slice...),
+		root:   -1,
+		parent: filled(n, -1),
+		left:   filled(n, -1),
+		right:  filled(n, -1),
+		first:  make([]int, n),
+	}
+
+	// The stack holds the right spine of the tree built so far
+	spine := linear.NewStack[int]()
+	for i, item := range slice {
+		last := -1
+		for !spine.IsEmpty() {
+			top, _ := spine.Peek()
+			if !less(item, slice[top]) {
+				break
+			}
+			last, _ = spine.Pop()
+		}
+		if last != -1 {
+			ct.left[i] = last
+			ct.parent[last] = i
+		}
+		if top, err := spine.Peek(); err == nil {
+			ct.right[top] = i
+			ct.parent[i] = top
+		}
+		spine.Push(i)
+	}
+	if !spine.IsEmpty() {
+		ct.root = spine.ToSlice()[0]
+	}
+
+	ct.buildTour()
+	return ct
+}
+
+// Root returns the index of the root, or -1 if the tree is empty
+func (ct *CartesianTree[T]) Root() int {
+	return ct.root
+}
+
+// Parent returns the index of the parent of node i, or -1 for the root
+func (ct *CartesianTree[T]) Parent(i int) int {
+	return ct.parent[i]
+}
+
+// Left returns the index of the left child of node i, or -1 if it has none
+func (ct *CartesianTree[T]) Left(i int) int {
+	return ct.left[i]
+}
+
+// Right returns the index of the right child of node i, or -1 if it has none
+func (ct *CartesianTree[T]) Right(i int) int {
+	return ct.right[i]
+}
+
+// LCA returns the lowest common ancestor of nodes u and v
+func (ct *CartesianTree[T]) LCA(u, v int) (int, error) {
+	if u < 0 || u >= ct.Size() || v < 0 || v >= ct.Size() {
+		return -1, errors.New("index out of bounds")
+	}
+	a, b := ct.first[u], ct.first[v]
+	if a > b {
+		a, b = b, a
+	}
+	return ct.tour.Query(a, b+1)
+}
+
+// MinIndex returns the index of the least element in the half-open range [l, r),
+// preferring the leftmost among equals. The range must not be empty.
+func (ct *CartesianTree[T]) MinIndex(l, r int) (int, error) {
+	if l < 0 || r > ct.Size() || l >= r {
+		return -1, errors.New("index out of bounds")
+	}
+	return ct.LCA(l, r-1)
+}
+
+// Min returns the least element in the half-open range [l, r), which must not be empty
+func (ct *CartesianTree[T]) Min(l, r int) (T, error) {
+	i, err := ct.MinIndex(l, r)
+	if err != nil {
+		return *new(T), err
+	}
+	return ct.items[i], nil
+}
+
+// Size returns the number of elements
+func (ct *CartesianTree[T]) Size() int {
+	return len(ct.items)
+}
+
+// buildTour records the Euler tour of the tree and indexes it by depth
+func (ct *CartesianTree[T]) buildTour() {
+	type frame struct {
+		node  int
+		stage int
+	}
+
+	n := len(ct.items)
+	depth := make([]int, n)
+	tour := make([]int, 0, max(2*n-1, 0))
+	stack := linear.NewStack[frame]()
+	if ct.root != -1 {
+		stack.Push(frame{node: ct.root})
+	}
+	// Each node is visited on entry and again after returning from each child
+	for !stack.IsEmpty() {
+		f, _ := stack.Pop()
+		u := f.node
+		switch f.stage {
+		case 0:
+			ct.first[u] = len(tour)
+			tour = append(tour, u)
+			stack.Push(frame{node: u, stage: 1})
+			if c := ct.left[u]; c != -1 {
+				depth[c] = depth[u] + 1
+				stack.Push(frame{node: c})
+			}
+		case 1:
+			if ct.left[u] != -1 {
+				tour = append(tour, u)
+			}
+			stack.Push(frame{node: u, stage: 2})
+			if c := ct.right[u]; c != -1 {
+				depth[c] = depth[u] + 1
+				stack.Push(frame{node: c})
+			}
+		default:
+			if ct.right[u] != -1 {
+				tour = append(tour, u)
+			}
+		}
+	}
+
+	ct.tour = FromSparseTableSlice(tour, func(a, b int) int {
+		if depth[b] < depth[a] {
+			return b
+		}
+		return a
+	})
+}
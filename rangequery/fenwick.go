@@ -0,0 +1,314 @@
+package rangequery
+
+import (
+	"errors"
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Number is the set of element types supported by the Fenwick trees
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Fenwick represents a Binary Indexed Tree over an array of numbers.
+// Point updates and prefix sums take O(log n) with n+1 words of storage.
+type Fenwick[T Number] struct {
+	// tree is 1-indexed: tree[i] sums the elements (i - i&-i, i]
+	tree []T
+}
+
+// NewFenwick creates a Fenwick tree of n zeros.
+// It panics if n is negative.
+func NewFenwick[T Number](n int) *Fenwick[T] {
+	if n < 0 {
+		panic("rangequery: size must not be negative")
+	}
+	return &Fenwick[T]{
+		tree: make([]T, n+1),
+	}
+}
+
+// FromFenwickSlice creates a Fenwick tree over the elements of a slice in O(n)
+func FromFenwickSlice[T Number](slice []T) *Fenwick[T] {
+	f := NewFenwick[T](len(slice))
+	copy(f.tree[1:], slice)
+	for i := 1; i < len(f.tree); i++ {
+		if parent := i + i&-i; parent < len(f.tree) {
+			f.tree[parent] += f.tree[i]
+		}
+	}
+	return f
+}
+
+// Add adds delta to the element at index i
+func (f *Fenwick[T]) Add(i int, delta T) error {
+	if i < 0 || i >= f.Size() {
+		return errors.New("index out of bounds")
+	}
+	for i++; i < len(f.tree); i += i & -i {
+		f.tree[i] += delta
+	}
+	return nil
+}
+
+// Set replaces the element at index i
+func (f *Fenwick[T]) Set(i int, value T) error {
+	current, err := f.Get(i)
+	if err != nil {
+		return err
+	}
+	return f.Add(i, value-current)
+}
+
+// Get returns the element at index i
+func (f *Fenwick[T]) Get(i int) (T, error) {
+	return f.RangeSum(i, i+1)
+}
+
+// PrefixSum returns the sum of the first i elements
+func (f *Fenwick[T]) PrefixSum(i int) (T, error) {
+	if i < 0 || i > f.Size() {
+		return 0, errors.New("index out of bounds")
+	}
+	return f.prefix(i), nil
+}
+
+// RangeSum returns the sum of the elements in the half-open range [l, r)
+func (f *Fenwick[T]) RangeSum(l, r int) (T, error) {
+	if l < 0 || r > f.Size() || l > r {
+		return 0, errors.New("index out of bounds")
+	}
+	return f.prefix(r) - f.prefix(l), nil
+}
+
+// LowerBound returns the smallest index i such that the sum of elements 0..i
+// is at least target, or Size() if there is none.
+// It requires every element to be non-negative.
+func (f *Fenwick[T]) LowerBound(target T) int {
+	if target <= 0 || f.Size() == 0 {
+		return 0
+	}
+	pos := 0
+	var sum T
+	for step := 1 << (bits.Len(uint(f.Size())) - 1); step > 0; step >>= 1 {
+		if next := pos + step; next < len(f.tree) && sum+f.tree[next] < target {
+			pos = next
+			sum += f.tree[next]
+		}
+	}
+	return pos
+}
+
+// Size returns the number of elements
+func (f *Fenwick[T]) Size() int {
+	return len(f.tree) - 1
+}
+
+// ToSlice returns the elements in index order
+func (f *Fenwick[T]) ToSlice() []T {
+	result := make([]T, f.Size())
+	for i := range result {
+		result[i], _ = f.Get(i)
+	}
+	return result
+}
+
+// String returns a string representation of the elements
+func (f *Fenwick[T]) String() string {
+	return formatSlice(f.ToSlice())
+}
+
+// prefix sums the first i elements without bounds checks
+func (f *Fenwick[T]) prefix(i int) T {
+	var sum T
+	for ; i > 0; i -= i & -i {
+		sum += f.tree[i]
+	}
+	return sum
+}
+
+// RangeFenwick represents an array of numbers that supports both range
+// updates and range sums in O(log n), using two Fenwick trees
+type RangeFenwick[T Number] struct {
+	// The prefix sum of the first i elements is i*slope.prefix(i) - offset.prefix(i)
+	slope  *Fenwick[T]
+	offset *Fenwick[T]
+}
+
+// NewRangeFenwick creates a range Fenwick tree of n zeros.
+// It panics if n is negative.
+func NewRangeFenwick[T Number](n int) *RangeFenwick[T] {
+	return &RangeFenwick[T]{
+		slope:  NewFenwick[T](n),
+		offset: NewFenwick[T](n),
+	}
+}
+
+// FromRangeFenwickSlice creates a range Fenwick tree over the elements of a slice in O(n)
+func FromRangeFenwickSlice[T Number](slice []T) *RangeFenwick[T] {
+	// Element i contributes slice[i] to slope at i and i*slice[i] to offset at i,
+	// and is cancelled again after i by storing the differences
+	slope := make([]T, len(slice))
+	offset := make([]T, len(slice))
+	for i, v := range slice {
+		prev := T(0)
+		if i > 0 {
+			prev = slice[i-1]
+		}
+		slope[i] = v - prev
+		offset[i] = (v - prev) * T(i)
+	}
+	return &RangeFenwick[T]{
+		slope:  FromFenwickSlice(slope),
+		offset: FromFenwickSlice(offset),
+	}
+}
+
+// AddRange adds delta to every element in the half-open range [l, r)
+func (f *RangeFenwick[T]) AddRange(l, r int, delta T) error {
+	if l < 0 || r > f.Size() || l > r {
+		return errors.New("index out of bounds")
+	}
+	if l == r {
+		return nil
+	}
+	f.slope.Add(l, delta)
+	f.offset.Add(l, delta*T(l))
+	if r < f.Size() {
+		f.slope.Add(r, -delta)
+		f.offset.Add(r, -delta*T(r))
+	}
+	return nil
+}
+
+// Add adds delta to the element at index i
+func (f *RangeFenwick[T]) Add(i int, delta T) error {
+	return f.AddRange(i, i+1, delta)
+}
+
+// Get returns the element at index i
+func (f *RangeFenwick[T]) Get(i int) (T, error) {
+	return f.RangeSum(i, i+1)
+}
+
+// PrefixSum returns the sum of the first i elements
+func (f *RangeFenwick[T]) PrefixSum(i int) (T, error) {
+	if i < 0 || i > f.Size() {
+		return 0, errors.New("index out of bounds")
+	}
+	return f.prefix(i), nil
+}
+
+// RangeSum returns the sum of the elements in the half-open range [l, r)
+func (f *RangeFenwick[T]) RangeSum(l, r int) (T, error) {
+	if l < 0 || r > f.Size() || l > r {
+		return 0, errors.New("index out of bounds")
+	}
+	return f.prefix(r) - f.prefix(l), nil
+}
+
+// Size returns the number of elements
+func (f *RangeFenwick[T]) Size() int {
+	return f.slope.Size()
+}
+
+// ToSlice returns the elements in index order
+func (f *RangeFenwick[T]) ToSlice() []T {
+	result := make([]T, f.Size())
+	for i := range result {
+		result[i] = f.slope.prefix(i + 1)
+	}
+	return result
+}
+
+// String returns a string representation of the elements
+func (f *RangeFenwick[T]) String() string {
+	return formatSlice(f.ToSlice())
+}
+
+// prefix sums the first i elements without bounds checks
+func (f *RangeFenwick[T]) prefix(i int) T {
+	return f.slope.prefix(i)*T(i) - f.offset.prefix(i)
+}
+
+// Fenwick2D represents a grid of numbers with O(log r * log c)
+// point updates and rectangle sums
+type Fenwick2D[T Number] struct {
+	rows int
+	cols int
+	tree [][]T
+}
+
+// NewFenwick2D creates a rows by cols grid of zeros.
+// It panics if either dimension is negative.
+func NewFenwick2D[T Number](rows, cols int) *Fenwick2D[T] {
+	if rows < 0 || cols < 0 {
+		panic("rangequery: size must not be negative")
+	}
+	tree := make([][]T, rows+1)
+	for i := range tree {
+		tree[i] = make([]T, cols+1)
+	}
+	return &Fenwick2D[T]{
+		rows: rows,
+		cols: cols,
+		tree: tree,
+	}
+}
+
+// Add adds delta to the cell at row r and column c
+func (f *Fenwick2D[T]) Add(r, c int, delta T) error {
+	if r < 0 || r >= f.rows || c < 0 || c >= f.cols {
+		return errors.New("index out of bounds")
+	}
+	for i := r + 1; i <= f.rows; i += i & -i {
+		for j := c + 1; j <= f.cols; j += j & -j {
+			f.tree[i][j] += delta
+		}
+	}
+	return nil
+}
+
+// Get returns the cell at row r and column c
+func (f *Fenwick2D[T]) Get(r, c int) (T, error) {
+	return f.RangeSum(r, c, r+1, c+1)
+}
+
+// PrefixSum returns the sum of the cells in the first r rows and c columns
+func (f *Fenwick2D[T]) PrefixSum(r, c int) (T, error) {
+	if r < 0 || r > f.rows || c < 0 || c > f.cols {
+		return 0, errors.New("index out of bounds")
+	}
+	return f.prefix(r, c), nil
+}
+
+// RangeSum returns the sum of the cells in rows [r1, r2) and columns [c1, c2)
+func (f *Fenwick2D[T]) RangeSum(r1, c1, r2, c2 int) (T, error) {
+	if r1 < 0 || c1 < 0 || r2 > f.rows || c2 > f.cols || r1 > r2 || c1 > c2 {
+		return 0, errors.New("index out of bounds")
+	}
+	return f.prefix(r2, c2) - f.prefix(r1, c2) - f.prefix(r2, c1) + f.prefix(r1, c1), nil
+}
+
+// Rows returns the number of rows
+func (f *Fenwick2D[T]) Rows() int {
+	return f.rows
+}
+
+// Cols returns the number of columns
+func (f *Fenwick2D[T]) Cols() int {
+	return f.cols
+}
+
+// prefix sums the first r rows and c columns without bounds checks
+func (f *Fenwick2D[T]) prefix(r, c int) T {
+	var sum T
+	for i := r; i > 0; i -= i & -i {
+		for j := c; j > 0; j -= j & -j {
+			sum += f.tree[i][j]
+		}
+	}
+	return sum
+}
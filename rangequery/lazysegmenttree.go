@@ -0,0 +1,278 @@
+package rangequery
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// LazyOps describes the operations of a LazySegmentTree: a monoid of values
+// (Combine, Identity) acted on by a monoid of updates (Compose, NoUpdate)
+type LazyOps[T any, F any] struct {
+	// Combine is an associative operation on values with neutral element Identity
+	Combine  func(a, b T) T
+	Identity T
+	// Apply returns the result of applying update f to x, the combination of length elements
+	Apply func(f F, x T, length int) T
+	// Compose returns the update equivalent to applying g and then f
+	Compose func(f, g F) F
+	// NoUpdate is the update that leaves every value unchanged
+	NoUpdate F
+}
+
+// LazySegmentTree represents a fixed-length array that supports range queries
+// and range updates in O(log n), deferring updates to subtrees until they are needed
+type LazySegmentTree[T any, F any] struct {
+	n    int
+	size int
+	log  int
+	data []T
+	lazy []F
+	ops  LazyOps[T, F]
+}
+
+// NewLazySegmentTree creates a lazy segment tree of n elements, all set to the identity.
+// It panics if n is negative.
+func NewLazySegmentTree[T any, F any](n int, ops LazyOps[T, F]) *LazySegmentTree[T, F] {
+	return FromLazySegmentTreeSlice(filled(n, ops.Identity), ops)
+}
+
+// FromLazySegmentTreeSlice creates a lazy segment tree over the elements of a slice in O(n)
+func FromLazySegmentTreeSlice[T any, F any](slice []T, ops LazyOps[T, F]) *LazySegmentTree[T, F] {
+	log := bits.Len(uint(max(len(slice), 1) - 1))
+	st := &LazySegmentTree[T, F]{
+		n:    len(slice),
+		size: 1 << log,
+		log:  log,
+		ops:  ops,
+	}
+	st.data = filled(2*st.size, ops.Identity)
+	st.lazy = filled(st.size, ops.NoUpdate)
+	copy(st.data[st.size:], slice)
+	for i := st.size - 1; i >= 1; i-- {
+		st.update(i)
+	}
+	return st
+}
+
+// Set replaces the element at index i
+func (st *LazySegmentTree[T, F]) Set(i int, value T) error {
+	if i < 0 || i >= st.n {
+		return errors.New("index out of bounds")
+	}
+	i += st.size
+	for j := st.log; j >= 1; j-- {
+		st.push(i >> j)
+	}
+	st.data[i] = value
+	for j := 1; j <= st.log; j++ {
+		st.update(i >> j)
+	}
+	return nil
+}
+
+// Get returns the element at index i
+func (st *LazySegmentTree[T, F]) Get(i int) (T, error) {
+	if i < 0 || i >= st.n {
+		return *new(T), errors.New("index out of bounds")
+	}
+	i += st.size
+	for j := st.log; j >= 1; j-- {
+		st.push(i >> j)
+	}
+	return st.data[i], nil
+}
+
+// Query combines the elements in the half-open range [l, r).
+// An empty range yields the identity.
+func (st *LazySegmentTree[T, F]) Query(l, r int) (T, error) {
+	if l < 0 || r > st.n || l > r {
+		return *new(T), errors.New("index out of bounds")
+	}
+	if l == r {
+		return st.ops.Identity, nil
+	}
+	l, r = l+st.size, r+st.size
+	st.pushBoundaries(l, r)
+
+	left, right := st.ops.Identity, st.ops.Identity
+	for ; l < r; l, r = l>>1, r>>1 {
+		if l&1 == 1 {
+			left = st.ops.Combine(left, st.data[l])
+			l++
+		}
+		if r&1 == 1 {
+			r--
+			right = st.ops.Combine(st.data[r], right)
+		}
+	}
+	return st.ops.Combine(left, right), nil
+}
+
+// QueryAll combines every element
+func (st *LazySegmentTree[T, F]) QueryAll() T {
+	return st.data[1]
+}
+
+// Apply applies update f to every element in the half-open range [l, r)
+func (st *LazySegmentTree[T, F]) Apply(l, r int, f F) error {
+	if l < 0 || r > st.n || l > r {
+		return errors.New("index out of bounds")
+	}
+	if l == r {
+		return nil
+	}
+	l, r = l+st.size, r+st.size
+	st.pushBoundaries(l, r)
+
+	for l2, r2 := l, r; l2 < r2; l2, r2 = l2>>1, r2>>1 {
+		if l2&1 == 1 {
+			st.applyAt(l2, f)
+			l2++
+		}
+		if r2&1 == 1 {
+			r2--
+			st.applyAt(r2, f)
+		}
+	}
+	for j := 1; j <= st.log; j++ {
+		if (l>>j)<<j != l {
+			st.update(l >> j)
+		}
+		if (r>>j)<<j != r {
+			st.update((r - 1) >> j)
+		}
+	}
+	return nil
+}
+
+// MaxRight returns the largest r such that pred holds for the combination of [l, r).
+// pred must hold for the identity and be monotone: once false it stays false as r grows.
+// It returns -1 if l is out of bounds.
+func (st *LazySegmentTree[T, F]) MaxRight(l int, pred func(T) bool) int {
+	if l < 0 || l > st.n {
+		return -1
+	}
+	if l == st.n {
+		return st.n
+	}
+	l += st.size
+	for j := st.log; j >= 1; j-- {
+		st.push(l >> j)
+	}
+	acc := st.ops.Identity
+	for {
+		for l%2 == 0 {
+			l >>= 1
+		}
+		if !pred(st.ops.Combine(acc, st.data[l])) {
+			// Descend to the first leaf that makes pred fail
+			for l < st.size {
+				st.push(l)
+				l *= 2
+				if next := st.ops.Combine(acc, st.data[l]); pred(next) {
+					acc = next
+					l++
+				}
+			}
+			return l - st.size
+		}
+		acc = st.ops.Combine(acc, st.data[l])
+		l++
+		if l&-l == l {
+			return st.n
+		}
+	}
+}
+
+// MinLeft returns the smallest l such that pred holds for the combination of [l, r).
+// pred must hold for the identity and be monotone: once false it stays false as l shrinks.
+// It returns -1 if r is out of bounds.
+func (st *LazySegmentTree[T, F]) MinLeft(r int, pred func(T) bool) int {
+	if r < 0 || r > st.n {
+		return -1
+	}
+	if r == 0 {
+		return 0
+	}
+	r += st.size
+	for j := st.log; j >= 1; j-- {
+		st.push((r - 1) >> j)
+	}
+	acc := st.ops.Identity
+	for {
+		r--
+		for r > 1 && r%2 == 1 {
+			r >>= 1
+		}
+		if !pred(st.ops.Combine(st.data[r], acc)) {
+			// Descend to the last leaf that makes pred fail
+			for r < st.size {
+				st.push(r)
+				r = 2*r + 1
+				if next := st.ops.Combine(st.data[r], acc); pred(next) {
+					acc = next
+					r--
+				}
+			}
+			return r + 1 - st.size
+		}
+		acc = st.ops.Combine(st.data[r], acc)
+		if r&-r == r {
+			return 0
+		}
+	}
+}
+
+// Size returns the number of elements
+func (st *LazySegmentTree[T, F]) Size() int {
+	return st.n
+}
+
+// ToSlice returns the elements in index order with all pending updates applied
+func (st *LazySegmentTree[T, F]) ToSlice() []T {
+	for i := 1; i < st.size; i++ {
+		st.push(i)
+	}
+	result := make([]T, st.n)
+	copy(result, st.data[st.size:st.size+st.n])
+	return result
+}
+
+// String returns a string representation of the elements
+func (st *LazySegmentTree[T, F]) String() string {
+	return formatSlice(st.ToSlice())
+}
+
+// update recomputes node i from its children
+func (st *LazySegmentTree[T, F]) update(i int) {
+	st.data[i] = st.ops.Combine(st.data[2*i], st.data[2*i+1])
+}
+
+// applyAt applies f to node i and records it as pending for the node's children
+func (st *LazySegmentTree[T, F]) applyAt(i int, f F) {
+	length := st.size >> (bits.Len(uint(i)) - 1)
+	st.data[i] = st.ops.Apply(f, st.data[i], length)
+	if i < st.size {
+		st.lazy[i] = st.ops.Compose(f, st.lazy[i])
+	}
+}
+
+// push hands the pending update of node i down to its children
+func (st *LazySegmentTree[T, F]) push(i int) {
+	st.applyAt(2*i, st.lazy[i])
+	st.applyAt(2*i+1, st.lazy[i])
+	st.lazy[i] = st.ops.NoUpdate
+}
+
+// pushBoundaries pushes pending updates along the paths to leaves l and r-1,
+// skipping nodes that lie entirely inside [l, r)
+func (st *LazySegmentTree[T, F]) pushBoundaries(l, r int) {
+	for j := st.log; j >= 1; j-- {
+		if (l>>j)<<j != l {
+			st.push(l >> j)
+		}
+		if (r>>j)<<j != r {
+			st.push((r - 1) >> j)
+		}
+	}
+}
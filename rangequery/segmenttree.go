@@ -0,0 +1,212 @@
+package rangequery
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// SegmentTree represents a fixed-length array that answers range queries
+// over an associative combine operation in O(log n).
+// combine must be associative and identity must be its neutral element,
+// for example + and 0, min and the largest value, or gcd and 0.
+type SegmentTree[T any] struct {
+	n        int
+	size     int
+	log      int
+	data     []T
+	combine  func(a, b T) T
+	identity T
+}
+
+// NewSegmentTree creates a segment tree of n elements, all set to identity.
+// It panics if n is negative.
+func NewSegmentTree[T any](n int, combine func(a, b T) T, identity T) *SegmentTree[T] {
+	return FromSegmentTreeSlice(filled(n, identity), combine, identity)
+}
+
+// FromSegmentTreeSlice creates a segment tree over the elements of a slice in O(n)
+func FromSegmentTreeSlice[T any](slice []T, combine func(a, b T) T, identity T) *SegmentTree[T] {
+	log := bits.Len(uint(max(len(slice), 1) - 1))
+	st := &SegmentTree[T]{
+		n:        len(slice),
+		size:     1 << log,
+		log:      log,
+		combine:  combine,
+		identity: identity,
+	}
+	st.data = make([]T, 2*st.size)
+	for i := range st.data {
+		st.data[i] = identity
+	}
+	copy(st.data[st.size:], slice)
+	for i := st.size - 1; i >= 1; i-- {
+		st.update(i)
+	}
+	return st
+}
+
+// Set replaces the element at index i
+func (st *SegmentTree[T]) Set(i int, value T) error {
+	if i < 0 || i >= st.n {
+		return errors.New("index out of bounds")
+	}
+	i += st.size
+	st.data[i] = value
+	for j := 1; j <= st.log; j++ {
+		st.update(i >> j)
+	}
+	return nil
+}
+
+// Get returns the element at index i
+func (st *SegmentTree[T]) Get(i int) (T, error) {
+	if i < 0 || i >= st.n {
+		return *new(T), errors.New("index out of bounds")
+	}
+	return st.data[i+st.size], nil
+}
+
+// Query combines the elements in the half-open range [l, r).
+// An empty range yields the identity.
+func (st *SegmentTree[T]) Query(l, r int) (T, error) {
+	if l < 0 || r > st.n || l > r {
+		return *new(T), errors.New("index out of bounds")
+	}
+	left, right := st.identity, st.identity
+	for l, r = l+st.size, r+st.size; l < r; l, r = l>>1, r>>1 {
+		if l&1 == 1 {
+			left = st.combine(left, st.data[l])
+			l++
+		}
+		if r&1 == 1 {
+			r--
+			right = st.combine(st.data[r], right)
+		}
+	}
+	return st.combine(left, right), nil
+}
+
+// QueryAll combines every element
+func (st *SegmentTree[T]) QueryAll() T {
+	return st.data[1]
+}
+
+// MaxRight returns the largest r such that pred holds for the combination of [l, r).
+// pred must hold for the identity and be monotone: once false it stays false as r grows.
+// It returns -1 if l is out of bounds.
+func (st *SegmentTree[T]) MaxRight(l int, pred func(T) bool) int {
+	if l < 0 || l > st.n {
+		return -1
+	}
+	if l == st.n {
+		return st.n
+	}
+	l += st.size
+	acc := st.identity
+	for {
+		for l%2 == 0 {
+			l >>= 1
+		}
+		if !pred(st.combine(acc, st.data[l])) {
+			// Descend to the first leaf that makes pred fail
+			for l < st.size {
+				l *= 2
+				if next := st.combine(acc, st.data[l]); pred(next) {
+					acc = next
+					l++
+				}
+			}
+			return l - st.size
+		}
+		acc = st.combine(acc, st.data[l])
+		l++
+		if l&-l == l {
+			return st.n
+		}
+	}
+}
+
+// MinLeft returns the smallest l such that pred holds for the combination of [l, r).
+// pred must hold for the identity and be monotone: once false it stays false as l shrinks.
+// It returns -1 if r is out of bounds.
+func (st *SegmentTree[T]) MinLeft(r int, pred func(T) bool) int {
+	if r < 0 || r > st.n {
+		return -1
+	}
+	if r == 0 {
+		return 0
+	}
+	r += st.size
+	acc := st.identity
+	for {
+		r--
+		for r > 1 && r%2 == 1 {
+			r >>= 1
+		}
+		if !pred(st.combine(st.data[r], acc)) {
+			// Descend to the last leaf that makes pred fail
+			for r < st.size {
+				r = 2*r + 1
+				if next := st.combine(st.data[r], acc); pred(next) {
+					acc = next
+					r--
+				}
+			}
+			return r + 1 - st.size
+		}
+		acc = st.combine(st.data[r], acc)
+		if r&-r == r {
+			return 0
+		}
+	}
+}
+
+// Size returns the number of elements
+func (st *SegmentTree[T]) Size() int {
+	return st.n
+}
+
+// ToSlice returns the elements in index order
+func (st *SegmentTree[T]) ToSlice() []T {
+	result := make([]T, st.n)
+	copy(result, st.data[st.size:st.size+st.n])
+	return result
+}
+
+// String returns a string representation of the elements
+func (st *SegmentTree[T]) String() string {
+	return formatSlice(st.ToSlice())
+}
+
+// update recomputes node i from its children
+func (st *SegmentTree[T]) update(i int) {
+	st.data[i] = st.combine(st.data[2*i], st.data[2*i+1])
+}
+
+// filled returns a slice of n copies of value, panicking if n is negative
+func filled[T any](n int, value T) []T {
+	if n < 0 {
+		panic("rangequery: size must not be negative")
+	}
+	slice := make([]T, n)
+	for i := range slice {
+		slice[i] = value
+	}
+	return slice
+}
+
+// formatSlice renders items as "[a b c]"
+func formatSlice[T any](items []T) string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i, item := range items {
+		if i > 0 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(fmt.Sprintf("%v", item))
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
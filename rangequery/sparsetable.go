@@ -0,0 +1,66 @@
+package rangequery
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// SparseTable represents an immutable array that answers range queries in O(1)
+// after O(n log n) preprocessing. op must be associative and idempotent,
+// meaning op(x, x) == x, as with min, max, gcd, bitwise and or bitwise or.
+type SparseTable[T any] struct {
+	// levels[k][i] combines the 2^k elements starting at index i
+	levels [][]T
+	op     func(a, b T) T
+}
+
+// FromSparseTableSlice creates a sparse table over the elements of a slice
+func FromSparseTableSlice[T any](slice []T, op func(a, b T) T) *SparseTable[T] {
+	st := &SparseTable[T]{
+		levels: [][]T{append([]T(nil), slice...)},
+		op:     op,
+	}
+	for k := 1; 1<<k <= len(slice); k++ {
+		prev := st.levels[k-1]
+		half := 1 << (k - 1)
+		level := make([]T, len(slice)-1<<k+1)
+		for i := range level {
+			level[i] = op(prev[i], prev[i+half])
+		}
+		st.levels = append(st.levels, level)
+	}
+	return st
+}
+
+// Query combines the elements in the half-open range [l, r), which must not be empty
+func (st *SparseTable[T]) Query(l, r int) (T, error) {
+	if l < 0 || r > st.Size() || l >= r {
+		return *new(T), errors.New("index out of bounds")
+	}
+	// Two overlapping power-of-two blocks cover the range, which idempotence allows
+	k := bits.Len(uint(r-l)) - 1
+	return st.op(st.levels[k][l], st.levels[k][r-1<<k]), nil
+}
+
+// Get returns the element at index i
+func (st *SparseTable[T]) Get(i int) (T, error) {
+	if i < 0 || i >= st.Size() {
+		return *new(T), errors.New("index out of bounds")
+	}
+	return st.levels[0][i], nil
+}
+
+// Size returns the number of elements
+func (st *SparseTable[T]) Size() int {
+	return len(st.levels[0])
+}
+
+// ToSlice returns the elements in index order
+func (st *SparseTable[T]) ToSlice() []T {
+	return append([]T(nil), st.levels[0]...)
+}
+
+// String returns a string representation of the elements
+func (st *SparseTable[T]) String() string {
+	return formatSlice(st.levels[0])
+}
@@ -0,0 +1,370 @@
+package set
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math/bits"
+	"strings"
+)
+
+// BitSet represents a set of non-negative integers stored one bit per value.
+// It grows automatically to hold the largest value set.
+type BitSet struct {
+	words []uint64
+}
+
+// NewBitSet creates an empty bit set with room for values below n without growing.
+// It panics if n is negative.
+func NewBitSet(n int) *BitSet {
+	if n < 0 {
+		panic("set: size must not be negative")
+	}
+	return &BitSet{
+		words: make([]uint64, (n+63)/64),
+	}
+}
+
+// FromBitSetSlice creates a new bit set containing the values of a slice.
+// It panics if any value is negative.
+func FromBitSetSlice(slice []int) *BitSet {
+	b := NewBitSet(0)
+	for _, i := range slice {
+		b.Set(i)
+	}
+	return b
+}
+
+// Set adds i to the set, growing it if needed
+func (b *BitSet) Set(i int) {
+	checkBit(i)
+	b.grow(i/64 + 1)
+	b.words[i/64] |= 1 << (i % 64)
+}
+
+// Clear removes i from the set
+func (b *BitSet) Clear(i int) {
+	checkBit(i)
+	if i/64 < len(b.words) {
+		b.words[i/64] &^= 1 << (i % 64)
+	}
+}
+
+// Flip toggles whether i is in the set, growing it if needed
+func (b *BitSet) Flip(i int) {
+	checkBit(i)
+	b.grow(i/64 + 1)
+	b.words[i/64] ^= 1 << (i % 64)
+}
+
+// Test returns true if i is in the set
+func (b *BitSet) Test(i int) bool {
+	checkBit(i)
+	return i/64 < len(b.words) && b.words[i/64]&(1<<(i%64)) != 0
+}
+
+// NextSet returns the smallest value in the set that is at least i
+func (b *BitSet) NextSet(i int) (int, bool) {
+	checkBit(i)
+	w := i / 64
+	if w >= len(b.words) {
+		return 0, false
+	}
+	// Mask off the bits below i in the first word
+	word := b.words[w] >> (i % 64) << (i % 64)
+	for {
+		if word != 0 {
+			return w*64 + bits.TrailingZeros64(word), true
+		}
+		w++
+		if w == len(b.words) {
+			return 0, false
+		}
+		word = b.words[w]
+	}
+}
+
+// NextClear returns the smallest value not in the set that is at least i
+func (b *BitSet) NextClear(i int) int {
+	checkBit(i)
+	w := i / 64
+	if w >= len(b.words) {
+		return i
+	}
+	// Treat the bits below i in the first word as set so they are skipped
+	word := b.words[w] | (1<<(i%64) - 1)
+	for {
+		if word != ^uint64(0) {
+			return w*64 + bits.TrailingZeros64(^word)
+		}
+		w++
+		if w == len(b.words) {
+			return w * 64
+		}
+		word = b.words[w]
+	}
+}
+
+// Count returns the number of values in the set
+func (b *BitSet) Count() int {
+	count := 0
+	for _, word := range b.words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// Len returns one more than the largest value in the set, or 0 if it is empty
+func (b *BitSet) Len() int {
+	for w := len(b.words) - 1; w >= 0; w-- {
+		if b.words[w] != 0 {
+			return w*64 + bits.Len64(b.words[w])
+		}
+	}
+	return 0
+}
+
+// Rank returns the number of values in the set that are less than i
+func (b *BitSet) Rank(i int) int {
+	checkBit(i)
+	w := min(i/64, len(b.words))
+	rank := 0
+	for _, word := range b.words[:w] {
+		rank += bits.OnesCount64(word)
+	}
+	if w < len(b.words) {
+		rank += bits.OnesCount64(b.words[w] & (1<<(i%64) - 1))
+	}
+	return rank
+}
+
+// Select returns the value at index k of the set in ascending order, counting from 0
+func (b *BitSet) Select(k int) (int, error) {
+	if k < 0 {
+		return 0, errors.New("index out of bounds")
+	}
+	for w, word := range b.words {
+		count := bits.OnesCount64(word)
+		if k >= count {
+			k -= count
+			continue
+		}
+		// Drop the k lowest set bits of the word
+		for range k {
+			word &= word - 1
+		}
+		return w*64 + bits.TrailingZeros64(word), nil
+	}
+	return 0, errors.New("index out of bounds")
+}
+
+// And returns a new bit set with the values in both b and other
+func (b *BitSet) And(other *BitSet) *BitSet {
+	result := b.Clone()
+	result.InPlaceAnd(other)
+	return result
+}
+
+// Or returns a new bit set with the values in b, other or both
+func (b *BitSet) Or(other *BitSet) *BitSet {
+	result := b.Clone()
+	result.InPlaceOr(other)
+	return result
+}
+
+// Xor returns a new bit set with the values in exactly one of b and other
+func (b *BitSet) Xor(other *BitSet) *BitSet {
+	result := b.Clone()
+	result.InPlaceXor(other)
+	return result
+}
+
+// AndNot returns a new bit set with the values in b but not in other
+func (b *BitSet) AndNot(other *BitSet) *BitSet {
+	result := b.Clone()
+	result.InPlaceAndNot(other)
+	return result
+}
+
+// InPlaceAnd removes from b every value not in other
+func (b *BitSet) InPlaceAnd(other *BitSet) {
+	n := min(len(b.words), len(other.words))
+	for i := range n {
+		b.words[i] &= other.words[i]
+	}
+	clear(b.words[n:])
+}
+
+// InPlaceOr adds every value of other to b
+func (b *BitSet) InPlaceOr(other *BitSet) {
+	b.grow(len(other.words))
+	for i, word := range other.words {
+		b.words[i] |= word
+	}
+}
+
+// InPlaceXor toggles every value of other in b
+func (b *BitSet) InPlaceXor(other *BitSet) {
+	b.grow(len(other.words))
+	for i, word := range other.words {
+		b.words[i] ^= word
+	}
+}
+
+// InPlaceAndNot removes every value of other from b
+func (b *BitSet) InPlaceAndNot(other *BitSet) {
+	n := min(len(b.words), len(other.words))
+	for i := range n {
+		b.words[i] &^= other.words[i]
+	}
+}
+
+// ShiftLeft returns a new bit set with every value increased by n.
+// It panics if n is negative.
+func (b *BitSet) ShiftLeft(n int) *BitSet {
+	if n < 0 {
+		panic("set: shift must not be negative")
+	}
+	wordShift, bitShift := n/64, n%64
+	result := &BitSet{words: make([]uint64, len(b.words)+wordShift+1)}
+	for i, word := range b.words {
+		result.words[i+wordShift] |= word << bitShift
+		if bitShift > 0 {
+			result.words[i+wordShift+1] |= word >> (64 - bitShift)
+		}
+	}
+	result.trim()
+	return result
+}
+
+// ShiftRight returns a new bit set with every value decreased by n,
+// dropping the values that would become negative.
+// It panics if n is negative.
+func (b *BitSet) ShiftRight(n int) *BitSet {
+	if n < 0 {
+		panic("set: shift must not be negative")
+	}
+	wordShift, bitShift := n/64, n%64
+	if wordShift >= len(b.words) {
+		return NewBitSet(0)
+	}
+	result := &BitSet{words: make([]uint64, len(b.words)-wordShift)}
+	for i := range result.words {
+		result.words[i] = b.words[i+wordShift] >> bitShift
+		if bitShift > 0 && i+wordShift+1 < len(b.words) {
+			result.words[i] |= b.words[i+wordShift+1] << (64 - bitShift)
+		}
+	}
+	result.trim()
+	return result
+}
+
+// IsSubset returns true if every value of b is also in other
+func (b *BitSet) IsSubset(other *BitSet) bool {
+	for i, word := range b.words {
+		var o uint64
+		if i < len(other.words) {
+			o = other.words[i]
+		}
+		if word&^o != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if b and other contain the same values
+func (b *BitSet) Equal(other *BitSet) bool {
+	return b.IsSubset(other) && other.IsSubset(b)
+}
+
+// Clone returns a copy of the bit set
+func (b *BitSet) Clone() *BitSet {
+	words := make([]uint64, len(b.words))
+	copy(words, b.words)
+	return &BitSet{words: words}
+}
+
+// All returns an iterator over the values in ascending order
+func (b *BitSet) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for w, word := range b.words {
+			for word != 0 {
+				if !yield(w*64 + bits.TrailingZeros64(word)) {
+					return
+				}
+				// Clear the lowest set bit
+				word &= word - 1
+			}
+		}
+	}
+}
+
+// IsEmpty returns true if the set has no values
+func (b *BitSet) IsEmpty() bool {
+	for _, word := range b.words {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Reset removes every value from the set, keeping its capacity
+func (b *BitSet) Reset() {
+	clear(b.words)
+}
+
+// ToSlice returns the values in ascending order
+func (b *BitSet) ToSlice() []int {
+	result := make([]int, 0, b.Count())
+	for i := range b.All() {
+		result = append(result, i)
+	}
+	return result
+}
+
+// String returns a string representation of the values in ascending order
+func (b *BitSet) String() string {
+	if b.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for i := range b.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(fmt.Sprintf("%d", i))
+		first = false
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// grow extends the set to at least n words
+func (b *BitSet) grow(n int) {
+	if n > len(b.words) {
+		b.words = append(b.words, make([]uint64, n-len(b.words))...)
+	}
+}
+
+// trim drops trailing zero words
+func (b *BitSet) trim() {
+	n := len(b.words)
+	for n > 0 && b.words[n-1] == 0 {
+		n--
+	}
+	b.words = b.words[:n]
+}
+
+// checkBit panics if i cannot be a member of a bit set
+func checkBit(i int) {
+	if i < 0 {
+		panic("set: bit index must not be negative")
+	}
+}
@@ -0,0 +1,203 @@
+package set
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/abhishekR-tech/collections/linear"
+	"github.com/abhishekR-tech/collections/ordered"
+)
+
+// CountEntry pairs an element with its count
+type CountEntry[T comparable] struct {
+	Value T
+	Count int
+}
+
+// Counter represents a frequency table of comparable elements.
+// Elements are kept in the order they were first counted, which makes
+// iteration and tie-breaking in MostCommon deterministic. Counts may become
+// negative through Add and Subtract; elements whose count reaches zero are dropped.
+type Counter[T comparable] struct {
+	counts *ordered.OrderedMap[T, int]
+}
+
+// NewCounter creates and returns a new empty counter
+func NewCounter[T comparable]() *Counter[T] {
+	return &Counter[T]{
+		counts: ordered.NewOrderedMap[T, int](),
+	}
+}
+
+// FromCounterSlice creates a new counter that counts the elements of a slice
+func FromCounterSlice[T comparable](slice []T) *Counter[T] {
+	c := NewCounter[T]()
+	for _, item := range slice {
+		c.Add(item, 1)
+	}
+	return c
+}
+
+// Add increases the count of item by n, which may be negative
+func (c *Counter[T]) Add(item T, n int) {
+	count, _ := c.counts.Peek(item)
+	c.set(item, count+n)
+}
+
+// Count returns the count of item, or 0 if it has not been counted
+func (c *Counter[T]) Count(item T) int {
+	count, _ := c.counts.Peek(item)
+	return count
+}
+
+// Remove drops item entirely and returns true if it was present
+func (c *Counter[T]) Remove(item T) bool {
+	return c.counts.Delete(item)
+}
+
+// Total returns the sum of all counts
+func (c *Counter[T]) Total() int {
+	total := 0
+	for _, count := range c.counts.All() {
+		total += count
+	}
+	return total
+}
+
+// MostCommon returns the k elements with the highest counts, highest first.
+// Ties keep the order in which elements were first counted. If k is not
+// positive, every element is returned.
+func (c *Counter[T]) MostCommon(k int) []CountEntry[T] {
+	type ranked struct {
+		entry CountEntry[T]
+		order int
+	}
+
+	if k <= 0 || k > c.counts.Size() {
+		k = c.counts.Size()
+	}
+	top := linear.NewTopK(k, func(a, b ranked) bool {
+		if a.entry.Count != b.entry.Count {
+			return a.entry.Count < b.entry.Count
+		}
+		return a.order > b.order
+	})
+
+	order := 0
+	for item, count := range c.counts.All() {
+		top.Push(ranked{entry: CountEntry[T]{Value: item, Count: count}, order: order})
+		order++
+	}
+
+	best := top.ToSlice()
+	result := make([]CountEntry[T], len(best))
+	for i, r := range best {
+		result[i] = r.entry
+	}
+	return result
+}
+
+// Update adds the counts of other to c in place
+func (c *Counter[T]) Update(other *Counter[T]) {
+	for item, count := range other.counts.All() {
+		c.Add(item, count)
+	}
+}
+
+// Subtract removes the counts of other from c in place; counts may become negative
+func (c *Counter[T]) Subtract(other *Counter[T]) {
+	for item, count := range other.counts.All() {
+		c.Add(item, -count)
+	}
+}
+
+// Plus returns a new counter with the counts of c and other added, keeping only positive counts
+func (c *Counter[T]) Plus(other *Counter[T]) *Counter[T] {
+	return c.combine(other, func(a, b int) int { return a + b })
+}
+
+// Minus returns a new counter with the counts of other subtracted from c, keeping only positive counts
+func (c *Counter[T]) Minus(other *Counter[T]) *Counter[T] {
+	return c.combine(other, func(a, b int) int { return a - b })
+}
+
+// Union returns a new counter with the larger of each pair of counts, keeping only positive counts
+func (c *Counter[T]) Union(other *Counter[T]) *Counter[T] {
+	return c.combine(other, func(a, b int) int { return max(a, b) })
+}
+
+// Intersection returns a new counter with the smaller of each pair of counts, keeping only positive counts
+func (c *Counter[T]) Intersection(other *Counter[T]) *Counter[T] {
+	return c.combine(other, func(a, b int) int { return min(a, b) })
+}
+
+// All returns an iterator over the elements and their counts in first-counted order
+func (c *Counter[T]) All() iter.Seq2[T, int] {
+	return c.counts.All()
+}
+
+// Size returns the number of distinct elements
+func (c *Counter[T]) Size() int {
+	return c.counts.Size()
+}
+
+// IsEmpty returns true if no elements have been counted
+func (c *Counter[T]) IsEmpty() bool {
+	return c.counts.IsEmpty()
+}
+
+// Clear removes all counts
+func (c *Counter[T]) Clear() {
+	c.counts.Clear()
+}
+
+// String returns a string representation of the counter in first-counted order
+func (c *Counter[T]) String() string {
+	if c.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for item, count := range c.counts.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v:%d", item, count))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// set stores count for item, dropping the item when the count is zero
+func (c *Counter[T]) set(item T, count int) {
+	if count == 0 {
+		c.counts.Delete(item)
+		return
+	}
+	c.counts.Put(item, count)
+}
+
+// combine merges c and other element-wise with op, keeping only positive results
+func (c *Counter[T]) combine(other *Counter[T], op func(a, b int) int) *Counter[T] {
+	result := NewCounter[T]()
+	for item, count := range c.counts.All() {
+		if n := op(count, other.Count(item)); n > 0 {
+			result.counts.Put(item, n)
+		}
+	}
+	for item, count := range other.counts.All() {
+		if c.counts.Contains(item) {
+			continue
+		}
+		if n := op(0, count); n > 0 {
+			result.counts.Put(item, n)
+		}
+	}
+	return result
+}
@@ -0,0 +1,257 @@
+package set
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// IntDisjointSet represents a union-find structure over the integers 0..n-1.
+// It uses path halving and union by size, so operations run in
+// near-constant amortized time.
+type IntDisjointSet struct {
+	parent []int
+	size   []int
+	count  int
+}
+
+// NewIntDisjointSet creates a disjoint set of n singleton groups 0..n-1.
+// It panics if n is negative.
+func NewIntDisjointSet(n int) *IntDisjointSet {
+	if n < 0 {
+		panic("set: size must not be negative")
+	}
+	d := &IntDisjointSet{
+		parent: make([]int, n),
+		size:   make([]int, n),
+		count:  n,
+	}
+	for i := range n {
+		d.parent[i] = i
+		d.size[i] = 1
+	}
+	return d
+}
+
+// Add appends a new singleton group and returns its element
+func (d *IntDisjointSet) Add() int {
+	x := len(d.parent)
+	d.parent = append(d.parent, x)
+	d.size = append(d.size, 1)
+	d.count++
+	return x
+}
+
+// Find returns the representative of the group containing x.
+// It panics if x is out of range.
+func (d *IntDisjointSet) Find(x int) int {
+	for d.parent[x] != x {
+		d.parent[x] = d.parent[d.parent[x]]
+		x = d.parent[x]
+	}
+	return x
+}
+
+// Union merges the groups containing a and b and returns true if they were separate
+func (d *IntDisjointSet) Union(a, b int) bool {
+	ra, rb := d.Find(a), d.Find(b)
+	if ra == rb {
+		return false
+	}
+	if d.size[ra] < d.size[rb] {
+		ra, rb = rb, ra
+	}
+	d.parent[rb] = ra
+	d.size[ra] += d.size[rb]
+	d.count--
+	return true
+}
+
+// Connected returns true if a and b are in the same group
+func (d *IntDisjointSet) Connected(a, b int) bool {
+	return d.Find(a) == d.Find(b)
+}
+
+// SetSize returns the number of elements in the group containing x
+func (d *IntDisjointSet) SetSize(x int) int {
+	return d.size[d.Find(x)]
+}
+
+// Count returns the number of groups
+func (d *IntDisjointSet) Count() int {
+	return d.count
+}
+
+// Size returns the number of elements
+func (d *IntDisjointSet) Size() int {
+	return len(d.parent)
+}
+
+// Groups returns an iterator over the groups, each in ascending order.
+// Groups are ordered by their smallest element.
+func (d *IntDisjointSet) Groups() iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		for _, group := range d.collect() {
+			if !yield(group) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the groups
+func (d *IntDisjointSet) String() string {
+	return formatGroups(d.Groups())
+}
+
+// collect gathers the elements of every group, ordered by smallest element
+func (d *IntDisjointSet) collect() [][]int {
+	index := make(map[int]int, d.count)
+	groups := make([][]int, 0, d.count)
+	for x := range d.parent {
+		root := d.Find(x)
+		i, ok := index[root]
+		if !ok {
+			i = len(groups)
+			index[root] = i
+			groups = append(groups, make([]int, 0, d.size[root]))
+		}
+		groups[i] = append(groups[i], x)
+	}
+	return groups
+}
+
+// DisjointSet represents a union-find structure over arbitrary comparable elements.
+// Elements are mapped to indices of an IntDisjointSet as they are first seen.
+type DisjointSet[T comparable] struct {
+	ids   map[T]int
+	items []T
+	sets  *IntDisjointSet
+}
+
+// NewDisjointSet creates and returns a new empty disjoint set
+func NewDisjointSet[T comparable]() *DisjointSet[T] {
+	return &DisjointSet[T]{
+		ids:  make(map[T]int),
+		sets: NewIntDisjointSet(0),
+	}
+}
+
+// FromDisjointSetSlice creates a disjoint set with each element of a slice in its own group
+func FromDisjointSetSlice[T comparable](slice []T) *DisjointSet[T] {
+	d := NewDisjointSet[T]()
+	for _, item := range slice {
+		d.Add(item)
+	}
+	return d
+}
+
+// Add inserts item as a singleton group and returns true if it was not already present
+func (d *DisjointSet[T]) Add(item T) bool {
+	if _, ok := d.ids[item]; ok {
+		return false
+	}
+	d.ids[item] = d.sets.Add()
+	d.items = append(d.items, item)
+	return true
+}
+
+// Contains returns true if item has been added
+func (d *DisjointSet[T]) Contains(item T) bool {
+	_, ok := d.ids[item]
+	return ok
+}
+
+// Find returns the representative of the group containing item
+func (d *DisjointSet[T]) Find(item T) (T, bool) {
+	id, ok := d.ids[item]
+	if !ok {
+		return *new(T), false
+	}
+	return d.items[d.sets.Find(id)], true
+}
+
+// Union merges the groups containing a and b, adding either if missing.
+// It returns true if they were separate.
+func (d *DisjointSet[T]) Union(a, b T) bool {
+	d.Add(a)
+	d.Add(b)
+	return d.sets.Union(d.ids[a], d.ids[b])
+}
+
+// Connected returns true if a and b are both present and in the same group
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+	ia, okA := d.ids[a]
+	ib, okB := d.ids[b]
+	return okA && okB && d.sets.Connected(ia, ib)
+}
+
+// SetSize returns the number of elements in the group containing item, or 0 if absent
+func (d *DisjointSet[T]) SetSize(item T) int {
+	id, ok := d.ids[item]
+	if !ok {
+		return 0
+	}
+	return d.sets.SetSize(id)
+}
+
+// Count returns the number of groups
+func (d *DisjointSet[T]) Count() int {
+	return d.sets.Count()
+}
+
+// Size returns the number of elements
+func (d *DisjointSet[T]) Size() int {
+	return len(d.items)
+}
+
+// IsEmpty returns true if the disjoint set has no elements
+func (d *DisjointSet[T]) IsEmpty() bool {
+	return len(d.items) == 0
+}
+
+// Clear removes all elements
+func (d *DisjointSet[T]) Clear() {
+	d.ids = make(map[T]int)
+	d.items = nil
+	d.sets = NewIntDisjointSet(0)
+}
+
+// Groups returns an iterator over the groups.
+// Groups and their elements follow the order in which elements were added.
+func (d *DisjointSet[T]) Groups() iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, ids := range d.sets.collect() {
+			group := make([]T, len(ids))
+			for i, id := range ids {
+				group[i] = d.items[id]
+			}
+			if !yield(group) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the groups
+func (d *DisjointSet[T]) String() string {
+	return formatGroups(d.Groups())
+}
+
+// formatGroups renders groups as "[[a b] [c]]"
+func formatGroups[T any](groups iter.Seq[[]T]) string {
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for group := range groups {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v", group))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
@@ -0,0 +1,179 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/abhishekR-tech/collections/tree"
+	"golang.org/x/exp/constraints"
+)
+
+// Multiset represents a sorted bag in which elements may occur more than once.
+// Each distinct element is stored once with its multiplicity in a tree.TreeMap.
+type Multiset[T any] struct {
+	counts *tree.TreeMap[T, int]
+	size   int
+}
+
+// NewMultiset creates a new empty multiset ordered by the natural order of T
+func NewMultiset[T constraints.Ordered]() *Multiset[T] {
+	return NewMultisetFunc(cmp.Compare[T])
+}
+
+// NewMultisetFunc creates a new empty multiset ordered by compare
+func NewMultisetFunc[T any](compare func(a, b T) int) *Multiset[T] {
+	return &Multiset[T]{
+		counts: tree.NewTreeMapFunc[T, int](compare),
+	}
+}
+
+// FromMultisetSlice creates a new multiset from the elements of a slice
+func FromMultisetSlice[T constraints.Ordered](slice []T) *Multiset[T] {
+	m := NewMultiset[T]()
+	for _, item := range slice {
+		m.Add(item)
+	}
+	return m
+}
+
+// Add inserts one occurrence of item
+func (m *Multiset[T]) Add(item T) {
+	m.AddN(item, 1)
+}
+
+// AddN inserts n occurrences of item; n must not be negative
+func (m *Multiset[T]) AddN(item T, n int) {
+	if n <= 0 {
+		return
+	}
+	count, _ := m.counts.Get(item)
+	m.counts.Put(item, count+n)
+	m.size += n
+}
+
+// Remove deletes one occurrence of item and returns true if it was present
+func (m *Multiset[T]) Remove(item T) bool {
+	return m.RemoveN(item, 1) == 1
+}
+
+// RemoveN deletes up to n occurrences of item and returns how many were removed
+func (m *Multiset[T]) RemoveN(item T, n int) int {
+	count, ok := m.counts.Get(item)
+	if !ok || n <= 0 {
+		return 0
+	}
+	removed := min(n, count)
+	if removed == count {
+		m.counts.Delete(item)
+	} else {
+		m.counts.Put(item, count-removed)
+	}
+	m.size -= removed
+	return removed
+}
+
+// RemoveAll deletes every occurrence of item and returns how many were removed
+func (m *Multiset[T]) RemoveAll(item T) int {
+	count, ok := m.counts.Get(item)
+	if !ok {
+		return 0
+	}
+	m.counts.Delete(item)
+	m.size -= count
+	return count
+}
+
+// Count returns the multiplicity of item
+func (m *Multiset[T]) Count(item T) int {
+	count, _ := m.counts.Get(item)
+	return count
+}
+
+// Contains returns true if item occurs at least once
+func (m *Multiset[T]) Contains(item T) bool {
+	return m.counts.Contains(item)
+}
+
+// Distinct returns the number of distinct elements
+func (m *Multiset[T]) Distinct() int {
+	return m.counts.Size()
+}
+
+// Min returns the smallest element
+func (m *Multiset[T]) Min() (T, bool) {
+	item, _, ok := m.counts.Min()
+	return item, ok
+}
+
+// Max returns the largest element
+func (m *Multiset[T]) Max() (T, bool) {
+	item, _, ok := m.counts.Max()
+	return item, ok
+}
+
+// All returns an iterator over the distinct elements in ascending order with their multiplicities
+func (m *Multiset[T]) All() iter.Seq2[T, int] {
+	return m.counts.All()
+}
+
+// Elements returns an iterator over every occurrence in ascending order
+func (m *Multiset[T]) Elements() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item, count := range m.counts.All() {
+			for range count {
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Size returns the total number of occurrences
+func (m *Multiset[T]) Size() int {
+	return m.size
+}
+
+// IsEmpty returns true if the multiset has no elements
+func (m *Multiset[T]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Clear removes all elements
+func (m *Multiset[T]) Clear() {
+	m.counts.Clear()
+	m.size = 0
+}
+
+// ToSlice returns every occurrence in ascending order
+func (m *Multiset[T]) ToSlice() []T {
+	result := make([]T, 0, m.size)
+	for item := range m.Elements() {
+		result = append(result, item)
+	}
+	return result
+}
+
+// String returns a string representation of the distinct elements and their multiplicities
+func (m *Multiset[T]) String() string {
+	if m.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for item, count := range m.counts.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v:%d", item, count))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
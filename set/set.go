@@ -0,0 +1,227 @@
+package set
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// Set represents an unordered collection of unique elements backed by a map
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewSet creates and returns a new empty set
+func NewSet[T comparable]() *Set[T] {
+	return &Set[T]{
+		items: make(map[T]struct{}),
+	}
+}
+
+// FromSetSlice creates a new set from the elements of a slice
+func FromSetSlice[T comparable](slice []T) *Set[T] {
+	s := &Set[T]{
+		items: make(map[T]struct{}, len(slice)),
+	}
+	for _, item := range slice {
+		s.items[item] = struct{}{}
+	}
+	return s
+}
+
+// Add inserts item and returns true if it was not already present
+func (s *Set[T]) Add(item T) bool {
+	if _, ok := s.items[item]; ok {
+		return false
+	}
+	s.items[item] = struct{}{}
+	return true
+}
+
+// Remove deletes item and returns true if it was present
+func (s *Set[T]) Remove(item T) bool {
+	if _, ok := s.items[item]; !ok {
+		return false
+	}
+	delete(s.items, item)
+	return true
+}
+
+// Contains returns true if item is in the set
+func (s *Set[T]) Contains(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Union returns a new set with the elements in s, other or both
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := s.Clone()
+	for item := range other.items {
+		result.items[item] = struct{}{}
+	}
+	return result
+}
+
+// Intersection returns a new set with the elements in both s and other
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	small, large := s, other
+	if small.Size() > large.Size() {
+		small, large = large, small
+	}
+
+	result := NewSet[T]()
+	for item := range small.items {
+		if large.Contains(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set with the elements in s that are not in other
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	result := NewSet[T]()
+	for item := range s.items {
+		if !other.Contains(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns a new set with the elements in exactly one of s and other
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	result := s.Difference(other)
+	for item := range other.items {
+		if !s.Contains(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IsSubset returns true if every element of s is in other
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+	for item := range s.items {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if every element of other is in s
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal returns true if s and other have exactly the same elements
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Size() == other.Size() && s.IsSubset(other)
+}
+
+// Clone returns a copy of the set
+func (s *Set[T]) Clone() *Set[T] {
+	result := &Set[T]{
+		items: make(map[T]struct{}, len(s.items)),
+	}
+	for item := range s.items {
+		result.items[item] = struct{}{}
+	}
+	return result
+}
+
+// All returns an iterator over the elements in unspecified order
+func (s *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the number of elements in the set
+func (s *Set[T]) Size() int {
+	return len(s.items)
+}
+
+// IsEmpty returns true if the set has no elements
+func (s *Set[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Clear removes all elements from the set
+func (s *Set[T]) Clear() {
+	s.items = make(map[T]struct{})
+}
+
+// ToSlice returns the elements as a slice in unspecified order
+func (s *Set[T]) ToSlice() []T {
+	result := make([]T, 0, len(s.items))
+	for item := range s.items {
+		result = append(result, item)
+	}
+	return result
+}
+
+// String returns a string representation of the set.
+// Elements are sorted by their formatted value so the output is deterministic.
+func (s *Set[T]) String() string {
+	if s.IsEmpty() {
+		return "[]"
+	}
+
+	formatted := make([]string, 0, len(s.items))
+	for item := range s.items {
+		formatted = append(formatted, fmt.Sprintf("%v", item))
+	}
+	slices.Sort(formatted)
+
+	var sb strings.Builder
+	sb.WriteString("[")
+	sb.WriteString(strings.Join(formatted, " "))
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// MarshalJSON encodes the set as a JSON array.
+// Elements are sorted by their encoding so the output is deterministic.
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	encoded := make([][]byte, 0, len(s.items))
+	for item := range s.items {
+		b, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, b)
+	}
+	slices.SortFunc(encoded, bytes.Compare)
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	buf.Write(bytes.Join(encoded, []byte{','}))
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON array into the set, replacing its contents.
+// Duplicate elements in the array are collapsed.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	s.items = make(map[T]struct{}, len(items))
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+	return nil
+}
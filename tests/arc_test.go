@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/abhishekR-tech/collections/cache"
+)
+
+func TestARCCache(t *testing.T) {
+	t.Run("Basic Operations", func(t *testing.T) {
+		arc := cache.NewARCCache[string, int](2, nil)
+		arc.Put("a", 1)
+		arc.Put("b", 2)
+
+		if val, ok := arc.Get("a"); !ok || val != 1 {
+			t.Errorf("Get('a') should return 1, got %d", val)
+		}
+		if val, ok := arc.Peek("b"); !ok || val != 2 {
+			t.Errorf("Peek('b') should return 2, got %d", val)
+		}
+
+		arc.Put("c", 3)
+		if arc.Len() != 2 {
+			t.Errorf("Cache should hold 2 entries, got %d", arc.Len())
+		}
+		if !arc.Contains("a") {
+			t.Error("Frequently used 'a' should stay cached")
+		}
+		if arc.Contains("b") {
+			t.Error("'b' should have been evicted")
+		}
+	})
+
+	t.Run("Scan Resistance", func(t *testing.T) {
+		arc := cache.NewARCCache[int, int](10, nil)
+
+		// Build a frequently used working set
+		for range 3 {
+			for i := range 5 {
+				arc.Put(i, i)
+				arc.Get(i)
+			}
+		}
+
+		// A long one-off scan should not flush the working set
+		for i := 100; i < 200; i++ {
+			arc.Put(i, i)
+		}
+
+		for i := range 5 {
+			if !arc.Contains(i) {
+				t.Errorf("Working set key %d should survive the scan", i)
+			}
+		}
+	})
+
+	t.Run("Ghost Hits Adapt Target", func(t *testing.T) {
+		arc := cache.NewARCCache[int, int](4, nil)
+		for i := range 4 {
+			arc.Put(i, i)
+		}
+		arc.Get(3)
+
+		// Evict 0 into the B1 ghost list, then request it again
+		arc.Put(4, 4)
+		if arc.Contains(0) {
+			t.Fatal("0 should have been evicted")
+		}
+		before := arc.Target()
+		arc.Put(0, 0)
+
+		if arc.Target() <= before {
+			t.Errorf("A B1 ghost hit should grow the target, got %d from %d", arc.Target(), before)
+		}
+		if !arc.Contains(0) || arc.Len() != 4 {
+			t.Error("Ghost hit should bring the key back into the cache")
+		}
+	})
+
+	t.Run("Remove, Resize and Callback", func(t *testing.T) {
+		evictions := 0
+		arc := cache.NewARCCache(4, func(key, value int) { evictions++ })
+		for i := range 4 {
+			arc.Put(i, i)
+		}
+
+		if !arc.Remove(1) || arc.Remove(1) {
+			t.Error("Remove should report presence correctly")
+		}
+		if n := arc.Resize(2); n != 1 {
+			t.Errorf("Resize should evict 1 entry, got %d", n)
+		}
+		if evictions != 1 || arc.Len() != 2 {
+			t.Errorf("Expected 1 eviction and 2 entries, got %d and %d", evictions, arc.Len())
+		}
+
+		arc.Clear()
+		if arc.Len() != 0 || arc.String() != "[]" || arc.Target() != 0 {
+			t.Error("Cache should be reset after Clear")
+		}
+	})
+}
+
+func TestCacheStats(t *testing.T) {
+	policies := map[string]cache.Cache[int, int]{
+		"LRU":     cache.NewLRUCache[int, int](2, nil),
+		"SyncLRU": cache.NewSyncLRUCache[int, int](2, nil),
+		"LFU":     cache.NewLFUCache[int, int](2, nil),
+		"ARC":     cache.NewARCCache[int, int](2, nil),
+	}
+
+	for name, c := range policies {
+		t.Run(name, func(t *testing.T) {
+			c.Put(1, 1)
+			c.Put(2, 2)
+			c.Get(1)
+			c.Get(3)
+			c.Peek(2)
+			c.Put(3, 3)
+
+			stats := c.Stats()
+			if stats.Hits != 1 || stats.Misses != 1 || stats.Evictions != 1 {
+				t.Errorf("Expected 1 hit, 1 miss and 1 eviction, got %+v", stats)
+			}
+			if stats.HitRatio() != 0.5 {
+				t.Errorf("Expected hit ratio 0.5, got %f", stats.HitRatio())
+			}
+
+			c.ResetStats()
+			if c.Stats() != (cache.Stats{}) {
+				t.Error("ResetStats should zero all counters")
+			}
+			if c.Stats().HitRatio() != 0 {
+				t.Error("Hit ratio with no lookups should be 0")
+			}
+		})
+	}
+}
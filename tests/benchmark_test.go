@@ -1,9 +1,18 @@
 package tests
 
 import (
+	"math/rand"
+	"strconv"
 	"testing"
 
+	"github.com/abhishekR-tech/collections/cache"
+	"github.com/abhishekR-tech/collections/graph"
 	"github.com/abhishekR-tech/collections/linear"
+	"github.com/abhishekR-tech/collections/probabilistic"
+	"github.com/abhishekR-tech/collections/rangequery"
+	"github.com/abhishekR-tech/collections/set"
+	"github.com/abhishekR-tech/collections/tree"
+	"github.com/abhishekR-tech/collections/trie"
 )
 
 // Stack Benchmarks
@@ -271,3 +280,514 @@ func BenchmarkDequeToSlice(b *testing.B) {
 		deque.ToSlice()
 	}
 }
+
+// PriorityQueue Benchmarks
+
+func BenchmarkPriorityQueuePush(b *testing.B) {
+	pq := linear.NewPriorityQueue(func(a, b int) bool { return a < b })
+	b.ResetTimer()
+	for i := range b.N {
+		pq.Push(i)
+	}
+}
+
+func BenchmarkPriorityQueuePop(b *testing.B) {
+	pq := linear.NewPriorityQueue(func(a, b int) bool { return a < b })
+	for i := range 10000 {
+		pq.Push(i)
+	}
+	b.ResetTimer()
+	for _ = range b.N {
+		pq.Pop()
+		if pq.IsEmpty() {
+			b.StopTimer()
+			for j := range 10000 {
+				pq.Push(j)
+			}
+			b.StartTimer()
+		}
+	}
+}
+
+// TopK Benchmarks
+
+func BenchmarkTopKPush(b *testing.B) {
+	top := linear.NewTopK(100, func(a, b int) bool { return a < b })
+	b.ResetTimer()
+	for i := range b.N {
+		top.Push(i)
+	}
+}
+
+// LRUCache Benchmarks
+
+func BenchmarkLRUCachePut(b *testing.B) {
+	lru := cache.NewLRUCache[int, int](1000, nil)
+	b.ResetTimer()
+	for i := range b.N {
+		lru.Put(i, i)
+	}
+}
+
+func BenchmarkLRUCacheGet(b *testing.B) {
+	lru := cache.NewLRUCache[int, int](1000, nil)
+	for i := range 1000 {
+		lru.Put(i, i)
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		lru.Get(i % 1000)
+	}
+}
+
+// Cache Policy Benchmarks
+
+// benchmarkCacheScan alternates a hot working set with one-off scans that are
+// as large as the cache, reporting the hit ratio so policies can be compared
+func benchmarkCacheScan(b *testing.B, c cache.Cache[int, int]) {
+	b.ResetTimer()
+	for i := range b.N {
+		key := i % 48
+		if i%160 >= 96 {
+			key = 1000 + i
+		}
+		if _, ok := c.Get(key); !ok {
+			c.Put(key, i)
+		}
+	}
+	b.ReportMetric(c.Stats().HitRatio(), "hit-ratio")
+}
+
+func BenchmarkCacheScanLRU(b *testing.B) {
+	benchmarkCacheScan(b, cache.NewLRUCache[int, int](64, nil))
+}
+
+func BenchmarkCacheScanLFU(b *testing.B) {
+	benchmarkCacheScan(b, cache.NewLFUCache[int, int](64, nil))
+}
+
+func BenchmarkCacheScanARC(b *testing.B) {
+	benchmarkCacheScan(b, cache.NewARCCache[int, int](64, nil))
+}
+
+// OrderStatisticTree Benchmarks
+
+func BenchmarkOrderStatisticTreeInsert(b *testing.B) {
+	ost := tree.NewOrderStatisticTree[int]()
+	b.ResetTimer()
+	for i := range b.N {
+		ost.Insert(i)
+	}
+}
+
+func BenchmarkOrderStatisticTreeSelect(b *testing.B) {
+	ost := tree.NewOrderStatisticTree[int]()
+	for i := range 10000 {
+		ost.Insert(i)
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		ost.Select(i % 10000)
+	}
+}
+
+// Sorted Container Benchmarks
+
+func BenchmarkBTreeInsert(b *testing.B) {
+	bt := tree.NewBTree[int](32)
+	rng := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+	for range b.N {
+		bt.Insert(rng.Int())
+	}
+}
+
+func BenchmarkTreeMapPut(b *testing.B) {
+	m := tree.NewTreeMap[int, struct{}]()
+	rng := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+	for range b.N {
+		m.Put(rng.Int(), struct{}{})
+	}
+}
+
+func BenchmarkBTreeGet(b *testing.B) {
+	bt := tree.NewBTree[int](32)
+	for i := range 100000 {
+		bt.Insert(i)
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		bt.Get(i % 100000)
+	}
+}
+
+func BenchmarkTreeMapGet(b *testing.B) {
+	m := tree.NewTreeMap[int, struct{}]()
+	for i := range 100000 {
+		m.Put(i, struct{}{})
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		m.Get(i % 100000)
+	}
+}
+
+func BenchmarkBTreeLoadSorted(b *testing.B) {
+	items := make([]int, 100000)
+	for i := range items {
+		items[i] = i
+	}
+	b.ResetTimer()
+	for _ = range b.N {
+		tree.NewBTree[int](32).LoadSorted(items)
+	}
+}
+
+func BenchmarkBTreeDeleteMin(b *testing.B) {
+	bt := tree.NewBTree[int](32)
+	for i := range 10000 {
+		bt.Insert(i)
+	}
+	b.ResetTimer()
+	for _ = range b.N {
+		bt.DeleteMin()
+		if bt.IsEmpty() {
+			b.StopTimer()
+			for j := range 10000 {
+				bt.Insert(j)
+			}
+			b.StartTimer()
+		}
+	}
+}
+
+func BenchmarkBTreeClone(b *testing.B) {
+	bt := tree.NewBTree[int](32)
+	for i := range 100000 {
+		bt.Insert(i)
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		clone := bt.Clone()
+		clone.Insert(-i)
+	}
+}
+
+// Trie Benchmarks
+
+func BenchmarkTrieInsert(b *testing.B) {
+	tr := trie.NewTrie[int]()
+	b.ResetTimer()
+	for i := range b.N {
+		tr.Insert(strconv.Itoa(i), i)
+	}
+}
+
+func BenchmarkRadixTreeInsert(b *testing.B) {
+	tr := trie.NewRadixTree[int]()
+	b.ResetTimer()
+	for i := range b.N {
+		tr.Insert(strconv.Itoa(i), i)
+	}
+}
+
+func BenchmarkTrieGet(b *testing.B) {
+	tr := trie.NewTrie[int]()
+	for i := range 10000 {
+		tr.Insert(strconv.Itoa(i), i)
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		tr.Get(strconv.Itoa(i % 10000))
+	}
+}
+
+func BenchmarkRadixTreeGet(b *testing.B) {
+	tr := trie.NewRadixTree[int]()
+	for i := range 10000 {
+		tr.Insert(strconv.Itoa(i), i)
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		tr.Get(strconv.Itoa(i % 10000))
+	}
+}
+
+// Set Benchmarks
+
+func BenchmarkSetAdd(b *testing.B) {
+	s := set.NewSet[int]()
+	b.ResetTimer()
+	for i := range b.N {
+		s.Add(i)
+	}
+}
+
+func BenchmarkSetIntersection(b *testing.B) {
+	x, y := set.NewSet[int](), set.NewSet[int]()
+	for i := range 10000 {
+		x.Add(i)
+		y.Add(i * 2)
+	}
+	b.ResetTimer()
+	for _ = range b.N {
+		x.Intersection(y)
+	}
+}
+
+// DisjointSet Benchmarks
+
+func BenchmarkIntDisjointSetUnion(b *testing.B) {
+	d := set.NewIntDisjointSet(10000)
+	b.ResetTimer()
+	for i := range b.N {
+		d.Union(i%10000, (i*7919)%10000)
+	}
+}
+
+func BenchmarkDisjointSetUnion(b *testing.B) {
+	d := set.NewDisjointSet[int]()
+	b.ResetTimer()
+	for i := range b.N {
+		d.Union(i%10000, (i*7919)%10000)
+	}
+}
+
+// Graph Benchmarks
+
+func BenchmarkGraphBFS(b *testing.B) {
+	g := graph.NewDirectedGraph[int, int]()
+	for i := range 10000 {
+		g.AddEdge(i, (i*31+7)%10000, 1)
+		g.AddEdge(i, (i+1)%10000, 1)
+	}
+	b.ResetTimer()
+	for _ = range b.N {
+		for range g.BFS(0) {
+		}
+	}
+}
+
+func BenchmarkDijkstra(b *testing.B) {
+	g := graph.NewDirectedGraph[int, int]()
+	rng := rand.New(rand.NewSource(1))
+	for i := range 10000 {
+		for range 4 {
+			g.AddEdge(i, rng.Intn(10000), rng.Intn(100))
+		}
+	}
+	b.ResetTimer()
+	for _ = range b.N {
+		graph.Dijkstra(g, 0)
+	}
+}
+
+func BenchmarkMaxFlow(b *testing.B) {
+	g := graph.NewDirectedGraph[int, int]()
+	rng := rand.New(rand.NewSource(1))
+	for i := range 1000 {
+		for range 4 {
+			g.AddEdge(i, rng.Intn(1000), rng.Intn(100))
+		}
+	}
+	b.ResetTimer()
+	for _ = range b.N {
+		graph.MaxFlow(g, 0, 999)
+	}
+}
+
+// Range Query Benchmarks
+
+func BenchmarkSegmentTreeQuery(b *testing.B) {
+	items := make([]int, 100000)
+	for i := range items {
+		items[i] = i
+	}
+	st := rangequery.FromSegmentTreeSlice(items, func(a, b int) int { return a + b }, 0)
+	b.ResetTimer()
+	for i := range b.N {
+		l := i % 50000
+		st.Query(l, l+50000)
+	}
+}
+
+func BenchmarkFenwickRangeSum(b *testing.B) {
+	items := make([]int, 100000)
+	for i := range items {
+		items[i] = i
+	}
+	f := rangequery.FromFenwickSlice(items)
+	b.ResetTimer()
+	for i := range b.N {
+		l := i % 50000
+		f.RangeSum(l, l+50000)
+	}
+}
+
+func BenchmarkSparseTableQuery(b *testing.B) {
+	items := make([]int, 100000)
+	for i := range items {
+		items[i] = (i * 7919) % 100000
+	}
+	st := rangequery.FromSparseTableSlice(items, func(a, b int) int { return min(a, b) })
+	b.ResetTimer()
+	for i := range b.N {
+		l := i % 50000
+		st.Query(l, l+50000)
+	}
+}
+
+// SkipList Benchmarks
+
+func BenchmarkSkipListPut(b *testing.B) {
+	sl := linear.NewSkipList[int, int]()
+	sl.Seed(1)
+	rng := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+	for i := range b.N {
+		sl.Put(rng.Int(), i)
+	}
+}
+
+func BenchmarkSkipListAt(b *testing.B) {
+	sl := linear.NewSkipList[int, int]()
+	sl.Seed(1)
+	for i := range 100000 {
+		sl.Put(i, i)
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		sl.At(i % 100000)
+	}
+}
+
+// Sequence Benchmarks
+
+func BenchmarkSequenceInsertMiddle(b *testing.B) {
+	s := tree.NewSequence[int]()
+	b.ResetTimer()
+	for i := range b.N {
+		s.Insert(i/2, i)
+	}
+}
+
+func BenchmarkSequenceReverse(b *testing.B) {
+	items := make([]int, 100000)
+	for i := range items {
+		items[i] = i
+	}
+	s := tree.FromSequenceSlice(items)
+	b.ResetTimer()
+	for i := range b.N {
+		l := i % 50000
+		s.Reverse(l, l+50000)
+	}
+}
+
+// Probabilistic Benchmarks
+
+func BenchmarkBloomFilterAdd(b *testing.B) {
+	f := probabilistic.NewBloomFilter[int](1000000, 0.01)
+	b.ResetTimer()
+	for i := range b.N {
+		f.Add(i)
+	}
+}
+
+func BenchmarkBloomFilterContains(b *testing.B) {
+	f := probabilistic.NewBloomFilter[int](1000000, 0.01)
+	for i := range 1000000 {
+		f.Add(i)
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		f.Contains(i)
+	}
+}
+
+func BenchmarkCountMinSketchAdd(b *testing.B) {
+	s := probabilistic.NewCountMinSketch[int](0.001, 0.01)
+	b.ResetTimer()
+	for i := range b.N {
+		s.Add(i%10000, 1)
+	}
+}
+
+func BenchmarkHeavyHittersAdd(b *testing.B) {
+	h := probabilistic.NewHeavyHitters[int](10, 0.001, 0.01)
+	b.ResetTimer()
+	for i := range b.N {
+		h.Add(i%1000, 1)
+	}
+}
+
+func BenchmarkHyperLogLogAdd(b *testing.B) {
+	h := probabilistic.NewHyperLogLog[int](14)
+	b.ResetTimer()
+	for i := range b.N {
+		h.Add(i)
+	}
+}
+
+func BenchmarkWeightedReservoirAdd(b *testing.B) {
+	r := probabilistic.NewWeightedReservoir[int](100)
+	r.Seed(1)
+	b.ResetTimer()
+	for i := range b.N {
+		r.Add(i, float64(i%10+1))
+	}
+}
+
+func BenchmarkCuckooFilterInsert(b *testing.B) {
+	f := probabilistic.NewCuckooFilter[int](b.N, 12, 4)
+	b.ResetTimer()
+	for i := range b.N {
+		f.Insert(i)
+	}
+}
+
+func BenchmarkCuckooFilterLookup(b *testing.B) {
+	f := probabilistic.NewCuckooFilter[int](1000000, 12, 4)
+	for i := range 1000000 {
+		f.Insert(i)
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		f.Lookup(i)
+	}
+}
+
+// BitSet Benchmarks
+
+func BenchmarkBitSetSet(b *testing.B) {
+	s := set.NewBitSet(1 << 20)
+	b.ResetTimer()
+	for i := range b.N {
+		s.Set(i % (1 << 20))
+	}
+}
+
+func BenchmarkBitSetInPlaceOr(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	x, y := set.NewBitSet(1<<16), set.NewBitSet(1<<16)
+	for range 1 << 14 {
+		x.Set(rng.Intn(1 << 16))
+		y.Set(rng.Intn(1 << 16))
+	}
+	b.ResetTimer()
+	for range b.N {
+		x.InPlaceOr(y)
+	}
+}
+
+func BenchmarkBitSetSelect(b *testing.B) {
+	s := set.NewBitSet(1 << 16)
+	for i := 0; i < 1<<16; i += 3 {
+		s.Set(i)
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		s.Select(i % s.Count())
+	}
+}
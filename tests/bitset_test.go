@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/set"
+)
+
+func TestBitSet(t *testing.T) {
+	t.Run("Set Clear Flip Test", func(t *testing.T) {
+		b := set.NewBitSet(10)
+		if !b.IsEmpty() || b.Count() != 0 || b.Len() != 0 || b.String() != "[]" {
+			t.Error("New bit set should be empty")
+		}
+
+		b.Set(3)
+		b.Set(200)
+		b.Flip(64)
+		b.Flip(3)
+		b.Clear(1000)
+		if b.Test(3) || !b.Test(64) || !b.Test(200) || b.Test(5000) {
+			t.Errorf("Unexpected contents %v", b)
+		}
+		if b.Count() != 2 || b.Len() != 201 {
+			t.Errorf("Expected 2 values below 201, got %d below %d", b.Count(), b.Len())
+		}
+		if b.String() != "[64 200]" {
+			t.Errorf("Expected [64 200], got %s", b.String())
+		}
+
+		b.Clear(200)
+		if b.Len() != 65 || !slices.Equal(b.ToSlice(), []int{64}) {
+			t.Errorf("Expected [64], got %v", b)
+		}
+
+		b.Reset()
+		if !b.IsEmpty() {
+			t.Error("Bit set should be empty after Reset")
+		}
+	})
+
+	t.Run("Next Set and Clear", func(t *testing.T) {
+		b := set.FromBitSetSlice([]int{0, 1, 2, 63, 64, 65, 130})
+
+		var got []int
+		for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+			got = append(got, i)
+		}
+		if !slices.Equal(got, []int{0, 1, 2, 63, 64, 65, 130}) {
+			t.Errorf("NextSet walked %v", got)
+		}
+		if _, ok := b.NextSet(131); ok {
+			t.Error("NextSet past the last value should fail")
+		}
+		if !slices.Equal(slices.Collect(b.All()), got) {
+			t.Errorf("All disagrees with NextSet: %v", slices.Collect(b.All()))
+		}
+
+		if b.NextClear(0) != 3 || b.NextClear(63) != 66 || b.NextClear(130) != 131 || b.NextClear(500) != 500 {
+			t.Errorf("Unexpected NextClear results %d %d %d %d",
+				b.NextClear(0), b.NextClear(63), b.NextClear(130), b.NextClear(500))
+		}
+
+		full := set.NewBitSet(0)
+		for i := range 128 {
+			full.Set(i)
+		}
+		if full.NextClear(5) != 128 {
+			t.Errorf("Expected 128 after a full prefix, got %d", full.NextClear(5))
+		}
+	})
+
+	t.Run("Set Algebra", func(t *testing.T) {
+		a := set.FromBitSetSlice([]int{1, 2, 3, 100})
+		b := set.FromBitSetSlice([]int{2, 3, 4, 300})
+
+		cases := []struct {
+			name string
+			got  *set.BitSet
+			want []int
+		}{
+			{"And", a.And(b), []int{2, 3}},
+			{"Or", a.Or(b), []int{1, 2, 3, 4, 100, 300}},
+			{"Xor", a.Xor(b), []int{1, 4, 100, 300}},
+			{"AndNot", a.AndNot(b), []int{1, 100}},
+		}
+		for _, c := range cases {
+			if !slices.Equal(c.got.ToSlice(), c.want) {
+				t.Errorf("%s: expected %v, got %v", c.name, c.want, c.got)
+			}
+		}
+		if !slices.Equal(a.ToSlice(), []int{1, 2, 3, 100}) {
+			t.Errorf("Allocating operations should not modify the receiver, got %v", a)
+		}
+
+		c := a.Clone()
+		c.InPlaceOr(b)
+		c.InPlaceAndNot(set.FromBitSetSlice([]int{1}))
+		c.InPlaceXor(set.FromBitSetSlice([]int{2, 5}))
+		c.InPlaceAnd(set.FromBitSetSlice([]int{3, 4, 5, 100}))
+		if !slices.Equal(c.ToSlice(), []int{3, 4, 5, 100}) {
+			t.Errorf("Expected [3 4 5 100], got %v", c)
+		}
+
+		if !a.And(b).IsSubset(a) || a.IsSubset(b) {
+			t.Error("IsSubset disagrees with contents")
+		}
+		if !a.Equal(set.FromBitSetSlice([]int{100, 3, 2, 1})) || a.Equal(b) {
+			t.Error("Equal disagrees with contents")
+		}
+		// Capacity does not affect equality
+		d := set.NewBitSet(1000)
+		d.Set(1)
+		if !d.Equal(set.FromBitSetSlice([]int{1})) {
+			t.Error("Bit sets with the same values should be equal")
+		}
+	})
+
+	t.Run("Shift", func(t *testing.T) {
+		b := set.FromBitSetSlice([]int{0, 5, 63, 64, 127})
+
+		if got := b.ShiftLeft(1).ToSlice(); !slices.Equal(got, []int{1, 6, 64, 65, 128}) {
+			t.Errorf("ShiftLeft(1) gave %v", got)
+		}
+		if got := b.ShiftLeft(130).ToSlice(); !slices.Equal(got, []int{130, 135, 193, 194, 257}) {
+			t.Errorf("ShiftLeft(130) gave %v", got)
+		}
+		if got := b.ShiftRight(5).ToSlice(); !slices.Equal(got, []int{0, 58, 59, 122}) {
+			t.Errorf("ShiftRight(5) gave %v", got)
+		}
+		if got := b.ShiftRight(64).ToSlice(); !slices.Equal(got, []int{0, 63}) {
+			t.Errorf("ShiftRight(64) gave %v", got)
+		}
+		if !b.ShiftRight(500).IsEmpty() {
+			t.Error("Shifting right past every value should give an empty set")
+		}
+		if !b.ShiftLeft(0).Equal(b) {
+			t.Error("Shifting by zero should copy the set")
+		}
+	})
+
+	t.Run("Subset Sum", func(t *testing.T) {
+		// Bit i of reachable is set when some subset of weights sums to i
+		reachable := set.FromBitSetSlice([]int{0})
+		for _, w := range []int{3, 5, 70} {
+			reachable.InPlaceOr(reachable.ShiftLeft(w))
+		}
+		want := []int{0, 3, 5, 8, 70, 73, 75, 78}
+		if !slices.Equal(reachable.ToSlice(), want) {
+			t.Errorf("Expected %v, got %v", want, reachable)
+		}
+	})
+
+	t.Run("Rank and Select", func(t *testing.T) {
+		b := set.FromBitSetSlice([]int{2, 64, 65, 200})
+
+		for i, want := range map[int]int{0: 0, 2: 0, 3: 1, 64: 1, 66: 3, 200: 3, 201: 4, 1000: 4} {
+			if b.Rank(i) != want {
+				t.Errorf("Rank(%d): expected %d, got %d", i, want, b.Rank(i))
+			}
+		}
+		for k, want := range []int{2, 64, 65, 200} {
+			if got, err := b.Select(k); err != nil || got != want {
+				t.Errorf("Select(%d): expected %d, got %d (%v)", k, want, got, err)
+			}
+			if b.Rank(want) != k {
+				t.Errorf("Rank should invert Select at %d", want)
+			}
+		}
+		if _, err := b.Select(4); err == nil {
+			t.Error("Expected an error selecting past the end")
+		}
+		if _, err := b.Select(-1); err == nil {
+			t.Error("Expected an error for a negative index")
+		}
+	})
+
+	t.Run("Negative Index", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected a panic for a negative value")
+			}
+		}()
+		set.NewBitSet(0).Set(-1)
+	})
+}
@@ -0,0 +1,337 @@
+package tests
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/probabilistic"
+)
+
+func TestBloomFilter(t *testing.T) {
+	t.Run("False Positive Rate", func(t *testing.T) {
+		f := probabilistic.NewBloomFilter[int](10000, 0.01)
+		if f.Size() != 95851 || f.HashCount() != 7 {
+			t.Errorf("Expected 95851 bits and 7 hashes, got %d and %d", f.Size(), f.HashCount())
+		}
+
+		for i := range 10000 {
+			f.Add(i)
+		}
+		for i := range 10000 {
+			if !f.Contains(i) {
+				t.Fatalf("Bloom filter must not report false negatives, missed %d", i)
+			}
+		}
+
+		falsePositives := 0
+		for i := 10000; i < 110000; i++ {
+			if f.Contains(i) {
+				falsePositives++
+			}
+		}
+		if rate := float64(falsePositives) / 100000; rate > 0.02 {
+			t.Errorf("False-positive rate %.4f is far above 0.01", rate)
+		}
+		if n := f.EstimatedCount(); math.Abs(float64(n-10000)) > 500 {
+			t.Errorf("Expected an estimate near 10000, got %d", n)
+		}
+
+		f.Clear()
+		if f.Contains(1) || f.EstimatedCount() != 0 {
+			t.Error("Filter should be empty after Clear")
+		}
+	})
+
+	t.Run("Union and Intersect", func(t *testing.T) {
+		seed := maphash.MakeSeed()
+		hash := func(s string) uint64 { return maphash.String(seed, s) }
+		a := probabilistic.NewBloomFilterFunc(100, 0.01, hash)
+		b := probabilistic.NewBloomFilterFunc(100, 0.01, hash)
+		a.Add("apple")
+		a.Add("banana")
+		b.Add("banana")
+		b.Add("cherry")
+
+		union := probabilistic.NewBloomFilterFunc(100, 0.01, hash)
+		union.Union(a)
+		union.Union(b)
+		for _, s := range []string{"apple", "banana", "cherry"} {
+			if !union.Contains(s) {
+				t.Errorf("Union should contain %s", s)
+			}
+		}
+
+		if err := a.Intersect(b); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if !a.Contains("banana") || a.Contains("apple") || a.Contains("cherry") {
+			t.Error("Intersection should only contain banana")
+		}
+
+		other := probabilistic.NewBloomFilterFunc(1000, 0.01, hash)
+		if err := a.Union(other); err == nil {
+			t.Error("Filters of different sizes should be rejected")
+		}
+	})
+
+	t.Run("Default Filters Combine", func(t *testing.T) {
+		a := probabilistic.NewBloomFilter[int](1000, 0.01)
+		b := probabilistic.NewBloomFilter[int](1000, 0.01)
+		for i := range 500 {
+			a.Add(i)
+			b.Add(i + 250)
+		}
+
+		union := probabilistic.NewBloomFilter[int](1000, 0.01)
+		if err := union.Union(a); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if err := union.Union(b); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		for i := range 750 {
+			if !union.Contains(i) {
+				t.Fatalf("Union of default filters lost %d", i)
+			}
+		}
+
+		if err := a.Intersect(b); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		for i := 250; i < 500; i++ {
+			if !a.Contains(i) {
+				t.Fatalf("Intersection of default filters lost %d", i)
+			}
+		}
+
+		// Element types without a portable hash share one seed per process
+		type point struct{ x, y int }
+		p := probabilistic.NewBloomFilter[point](100, 0.01)
+		q := probabilistic.NewBloomFilter[point](100, 0.01)
+		q.Add(point{1, 2})
+		if err := p.Union(q); err != nil || !p.Contains(point{1, 2}) {
+			t.Errorf("Union of default struct filters failed: %v", err)
+		}
+
+		seeded := probabilistic.NewBloomFilter[int](1000, 0.01)
+		seeded.Seed(7)
+		if err := union.Union(seeded); err == nil {
+			t.Error("Filters with different seeds should be rejected")
+		}
+		custom := probabilistic.NewBloomFilterFunc(1000, 0.01, func(i int) uint64 { return uint64(i) })
+		if err := union.Intersect(custom); err == nil {
+			t.Error("Filters with different hashes should be rejected")
+		}
+	})
+
+	t.Run("Serialization", func(t *testing.T) {
+		seed := maphash.MakeSeed()
+		hash := func(s string) uint64 { return maphash.String(seed, s) }
+		f := probabilistic.NewBloomFilterFunc(1000, 0.001, hash)
+		for i := range 1000 {
+			f.Add("user-" + strconv.Itoa(i))
+		}
+
+		data, err := f.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		loaded := probabilistic.NewBloomFilterFunc(1, 0.5, hash)
+		if err := loaded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if loaded.Size() != f.Size() || loaded.HashCount() != f.HashCount() {
+			t.Error("Decoded filter should adopt the encoded geometry")
+		}
+		for i := range 1000 {
+			if !loaded.Contains("user-" + strconv.Itoa(i)) {
+				t.Fatalf("Decoded filter lost user-%d", i)
+			}
+		}
+
+		if err := loaded.UnmarshalBinary(data[:20]); err == nil {
+			t.Error("Truncated data should be rejected")
+		}
+		if loaded.Size() != f.Size() {
+			t.Error("Failed decoding should leave the filter unchanged")
+		}
+	})
+
+	t.Run("Default Filter Serialization", func(t *testing.T) {
+		f := probabilistic.NewBloomFilter[string](1000, 0.01)
+		f.Seed(42)
+		for i := range 1000 {
+			f.Add("user-" + strconv.Itoa(i))
+		}
+		data, _ := f.MarshalBinary()
+
+		// The decoder adopts the encoded seed
+		loaded := probabilistic.NewBloomFilter[string](1, 0.5)
+		if err := loaded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		for i := range 1000 {
+			if !loaded.Contains("user-" + strconv.Itoa(i)) {
+				t.Fatalf("Decoded filter lost user-%d", i)
+			}
+		}
+		if err := loaded.Union(f); err != nil {
+			t.Errorf("Decoded filter should be compatible with its source: %v", err)
+		}
+
+		custom := probabilistic.NewBloomFilterFunc(1, 0.5, func(s string) uint64 { return uint64(len(s)) })
+		if err := custom.UnmarshalBinary(data); err == nil {
+			t.Error("Data encoded with a different hash should be rejected")
+		}
+
+		// Filters of strings with the same seed hash identically
+		g := probabilistic.NewBloomFilter[string](1000, 0.01)
+		g.Seed(42)
+		for i := range 1000 {
+			g.Add("user-" + strconv.Itoa(i))
+		}
+		if again, _ := g.MarshalBinary(); string(again) != string(data) {
+			t.Error("Filters with the same seed and contents should encode identically")
+		}
+	})
+
+	t.Run("Large Geometry", func(t *testing.T) {
+		if math.MaxInt == math.MaxInt32 {
+			t.Skip("positions beyond 32 bits need a 64-bit int")
+		}
+		f := probabilistic.NewBloomFilterFunc(1000, 0.01, func(s string) uint64 { return uint64(len(s)) })
+		data, _ := f.MarshalBinary()
+		large := slices.Clone(data)
+		binary.LittleEndian.PutUint64(large, math.MaxInt32+1)
+
+		// The geometry is accepted, so decoding fails on the hash and then on the body
+		other := probabilistic.NewBloomFilter[string](1, 0.5)
+		if err := other.UnmarshalBinary(large); err == nil || !strings.Contains(err.Error(), "different hash") {
+			t.Errorf("Sizes beyond 32 bits should pass the header check, got %v", err)
+		}
+		if err := f.UnmarshalBinary(large); err == nil || f.Size() != 9586 {
+			t.Errorf("A body too short for the encoded size should be rejected, got %v", err)
+		}
+
+		binary.LittleEndian.PutUint64(large, math.MaxUint64)
+		if err := f.UnmarshalBinary(large); err == nil || strings.Contains(err.Error(), "different hash") {
+			t.Errorf("Sizes beyond the range of int should be rejected, got %v", err)
+		}
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		for _, p := range []float64{0, 1, -0.5} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Rate %v should panic", p)
+					}
+				}()
+				probabilistic.NewBloomFilter[int](10, p)
+			}()
+		}
+	})
+}
+
+func TestCountingBloomFilter(t *testing.T) {
+	t.Run("Deletion", func(t *testing.T) {
+		f := probabilistic.NewCountingBloomFilter[string](1000, 0.01)
+		f.Add("alpha")
+		f.Add("beta")
+		f.Add("beta")
+
+		if !f.Remove("beta") || !f.Contains("beta") {
+			t.Error("One of two occurrences should remain after Remove")
+		}
+		if !f.Remove("beta") || f.Contains("beta") {
+			t.Error("beta should be gone after removing both occurrences")
+		}
+		if f.Remove("gamma") {
+			t.Error("Removing an absent item should return false")
+		}
+		if !f.Contains("alpha") || f.EstimatedCount() != 1 {
+			t.Error("Unrelated items should survive removals")
+		}
+	})
+
+	t.Run("Default Filters Combine", func(t *testing.T) {
+		a := probabilistic.NewCountingBloomFilter[string](100, 0.01)
+		b := probabilistic.NewCountingBloomFilter[string](100, 0.01)
+		a.Add("apple")
+		b.Add("banana")
+		if err := a.Union(b); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if !a.Contains("apple") || !a.Contains("banana") {
+			t.Error("Union of default filters lost an element")
+		}
+
+		b.Seed(1)
+		if err := a.Union(b); err == nil {
+			t.Error("Filters with different seeds should be rejected")
+		}
+		data, _ := a.MarshalBinary()
+		if err := b.UnmarshalBinary(data); err != nil || !b.Contains("banana") {
+			t.Errorf("Default filter should reload its own encoding: %v", err)
+		}
+	})
+
+	t.Run("Large Geometry", func(t *testing.T) {
+		if math.MaxInt == math.MaxInt32 {
+			t.Skip("positions beyond 32 bits need a 64-bit int")
+		}
+		f := probabilistic.NewCountingBloomFilterFunc(1000, 0.01, func(n int) uint64 { return uint64(n) })
+		data, _ := f.MarshalBinary()
+		large := slices.Clone(data)
+		binary.LittleEndian.PutUint64(large, math.MaxInt32+1)
+
+		other := probabilistic.NewCountingBloomFilter[int](1, 0.5)
+		if err := other.UnmarshalBinary(large); err == nil || !strings.Contains(err.Error(), "different hash") {
+			t.Errorf("Sizes beyond 32 bits should pass the header check, got %v", err)
+		}
+		if err := f.UnmarshalBinary(large); err == nil || f.Size() != 9586 {
+			t.Errorf("A body too short for the encoded size should be rejected, got %v", err)
+		}
+	})
+
+	t.Run("Union, Intersect and Serialization", func(t *testing.T) {
+		seed := maphash.MakeSeed()
+		hash := func(n int) uint64 { return maphash.Comparable(seed, n) }
+		a := probabilistic.NewCountingBloomFilterFunc(500, 0.01, hash)
+		b := probabilistic.NewCountingBloomFilterFunc(500, 0.01, hash)
+		for i := range 100 {
+			a.Add(i)
+			b.Add(i + 50)
+		}
+
+		data, _ := a.MarshalBinary()
+		if err := a.Union(b); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		for i := range 150 {
+			if !a.Contains(i) {
+				t.Fatalf("Union lost %d", i)
+			}
+		}
+
+		restored := probabilistic.NewCountingBloomFilterFunc(1, 0.5, hash)
+		if err := restored.UnmarshalBinary(data); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		restored.Intersect(b)
+		for i := 50; i < 100; i++ {
+			if !restored.Contains(i) {
+				t.Fatalf("Intersection lost %d", i)
+			}
+		}
+		restored.Clear()
+		if restored.Contains(60) {
+			t.Error("Filter should be empty after Clear")
+		}
+	})
+}
@@ -0,0 +1,218 @@
+package tests
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/tree"
+)
+
+func TestBTree(t *testing.T) {
+	t.Run("Insert, Get and Delete", func(t *testing.T) {
+		bt := tree.NewBTree[int](2)
+
+		if !bt.IsEmpty() {
+			t.Error("New B-tree should be empty")
+		}
+
+		for _, v := range []int{50, 20, 80, 10, 30, 70, 90, 60, 40} {
+			if !bt.Insert(v) {
+				t.Errorf("Insert(%d) should report a new item", v)
+			}
+		}
+		if bt.Insert(30) {
+			t.Error("Insert of an existing item should return false")
+		}
+		if bt.Size() != 9 {
+			t.Errorf("B-tree size should be 9, got %d", bt.Size())
+		}
+
+		if v, ok := bt.Get(70); !ok || v != 70 {
+			t.Errorf("Get(70) should find 70, got %d", v)
+		}
+		if bt.Has(35) {
+			t.Error("Has(35) should be false")
+		}
+
+		if v, ok := bt.Delete(20); !ok || v != 20 {
+			t.Errorf("Delete(20) should return 20, got %d", v)
+		}
+		if _, ok := bt.Delete(20); ok {
+			t.Error("Deleting a missing item should report false")
+		}
+		if bt.String() != "[10 30 40 50 60 70 80 90]" {
+			t.Errorf("Expected [10 30 40 50 60 70 80 90], got %s", bt.String())
+		}
+		if bt.Degree() != 2 {
+			t.Errorf("Degree should be 2, got %d", bt.Degree())
+		}
+	})
+
+	t.Run("Min, Max, DeleteMin and DeleteMax", func(t *testing.T) {
+		bt := tree.NewBTree[int](3)
+		for i := range 100 {
+			bt.Insert(i)
+		}
+
+		if v, _ := bt.Min(); v != 0 {
+			t.Errorf("Min should be 0, got %d", v)
+		}
+		if v, _ := bt.Max(); v != 99 {
+			t.Errorf("Max should be 99, got %d", v)
+		}
+
+		for i := range 50 {
+			if v, ok := bt.DeleteMin(); !ok || v != i {
+				t.Fatalf("DeleteMin should return %d, got %d", i, v)
+			}
+			if v, ok := bt.DeleteMax(); !ok || v != 99-i {
+				t.Fatalf("DeleteMax should return %d, got %d", 99-i, v)
+			}
+		}
+
+		if !bt.IsEmpty() {
+			t.Error("B-tree should be empty after removing everything")
+		}
+		if _, ok := bt.DeleteMin(); ok {
+			t.Error("DeleteMin on empty tree should report false")
+		}
+		if _, ok := bt.Max(); ok {
+			t.Error("Max on empty tree should report false")
+		}
+	})
+
+	t.Run("Iteration from Pivot", func(t *testing.T) {
+		bt := tree.NewBTree[int](2)
+		for i := range 20 {
+			bt.Insert(i * 5)
+		}
+
+		var ascending []int
+		for v := range bt.AscendFrom(42) {
+			ascending = append(ascending, v)
+			if len(ascending) == 3 {
+				break
+			}
+		}
+		if !slices.Equal(ascending, []int{45, 50, 55}) {
+			t.Errorf("AscendFrom(42) should start [45 50 55], got %v", ascending)
+		}
+
+		var descending []int
+		for v := range bt.DescendFrom(45) {
+			descending = append(descending, v)
+			if len(descending) == 3 {
+				break
+			}
+		}
+		if !slices.Equal(descending, []int{45, 40, 35}) {
+			t.Errorf("DescendFrom(45) should start [45 40 35], got %v", descending)
+		}
+
+		all := slices.Collect(bt.All())
+		backward := slices.Collect(bt.Backward())
+		slices.Reverse(backward)
+		if len(all) != 20 || !slices.Equal(all, backward) {
+			t.Error("All and Backward should visit the same items in opposite orders")
+		}
+	})
+
+	t.Run("LoadSorted", func(t *testing.T) {
+		items := make([]int, 1000)
+		for i := range items {
+			items[i] = i * 3
+		}
+
+		bt := tree.NewBTree[int](4)
+		if err := bt.LoadSorted(items); err != nil {
+			t.Fatalf("LoadSorted should succeed, got %v", err)
+		}
+		if bt.Size() != 1000 || !slices.Equal(bt.ToSlice(), items) {
+			t.Error("Loaded tree should contain exactly the input items")
+		}
+
+		// The loaded tree stays fully usable
+		bt.Insert(1)
+		bt.Delete(0)
+		if v, _ := bt.Min(); v != 1 {
+			t.Errorf("Min after edits should be 1, got %d", v)
+		}
+
+		if err := bt.LoadSorted(items); err == nil {
+			t.Error("LoadSorted on non-empty tree should return error")
+		}
+		if err := tree.NewBTree[int](4).LoadSorted([]int{1, 3, 2}); err == nil {
+			t.Error("LoadSorted with unsorted input should return error")
+		}
+		if err := tree.NewBTree[int](4).LoadSorted([]int{1, 1}); err == nil {
+			t.Error("LoadSorted with duplicates should return error")
+		}
+	})
+
+	t.Run("Clone Is Copy-on-Write", func(t *testing.T) {
+		original := tree.NewBTree[int](2)
+		for i := range 50 {
+			original.Insert(i)
+		}
+
+		snapshot := original.Clone()
+		original.Delete(10)
+		original.Insert(100)
+		snapshot.Insert(-1)
+
+		if !snapshot.Has(10) || snapshot.Has(100) {
+			t.Error("Snapshot should not see changes made to the original")
+		}
+		if original.Has(-1) {
+			t.Error("Original should not see changes made to the snapshot")
+		}
+		if original.Size() != 50 || snapshot.Size() != 51 {
+			t.Errorf("Expected sizes 50 and 51, got %d and %d", original.Size(), snapshot.Size())
+		}
+	})
+
+	t.Run("Random Operations Match Reference", func(t *testing.T) {
+		bt := tree.NewBTreeFunc(3, func(a, b int) int { return a - b })
+		reference := map[int]bool{}
+		rng := rand.New(rand.NewSource(11))
+
+		for range 5000 {
+			v := rng.Intn(400)
+			if rng.Intn(2) == 0 {
+				bt.Insert(v)
+				reference[v] = true
+			} else {
+				_, ok := bt.Delete(v)
+				if ok != reference[v] {
+					t.Fatalf("Delete(%d) disagreed with reference", v)
+				}
+				delete(reference, v)
+			}
+		}
+
+		items := bt.ToSlice()
+		if len(items) != len(reference) || !slices.IsSorted(items) {
+			t.Fatal("B-tree contents should be sorted and match the reference")
+		}
+		for _, v := range items {
+			if !reference[v] {
+				t.Fatalf("Unexpected item %d", v)
+			}
+		}
+
+		bt.Clear()
+		if !bt.IsEmpty() || bt.String() != "[]" {
+			t.Error("B-tree should be empty after Clear")
+		}
+	})
+
+	t.Run("Invalid Degree", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("NewBTree should panic for degree below 2")
+			}
+		}()
+		tree.NewBTree[int](1)
+	})
+}
@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/set"
+)
+
+func TestCounter(t *testing.T) {
+	t.Run("Counting", func(t *testing.T) {
+		c := set.FromCounterSlice(strings.Split("the cat and the hat and the bat", " "))
+
+		if c.Count("the") != 3 || c.Count("and") != 2 || c.Count("dog") != 0 {
+			t.Errorf("Unexpected counts %s", c.String())
+		}
+		if c.Total() != 8 || c.Size() != 5 {
+			t.Errorf("Expected total 8 over 5 words, got %d over %d", c.Total(), c.Size())
+		}
+
+		c.Add("cat", 4)
+		c.Add("bat", -1)
+		if c.Count("cat") != 5 || c.Count("bat") != 0 || c.Size() != 4 {
+			t.Errorf("Add should adjust counts and drop zeros, got %s", c.String())
+		}
+		if c.String() != "[the:3 cat:5 and:2 hat:1]" {
+			t.Errorf("Expected first-counted order, got %s", c.String())
+		}
+
+		if !c.Remove("hat") || c.Remove("hat") {
+			t.Error("Remove should report presence correctly")
+		}
+
+		c.Clear()
+		if !c.IsEmpty() || c.String() != "[]" {
+			t.Error("Counter should be empty after Clear")
+		}
+	})
+
+	t.Run("MostCommon", func(t *testing.T) {
+		c := set.FromCounterSlice([]rune("abracadabra"))
+
+		top := c.MostCommon(3)
+		expected := []set.CountEntry[rune]{{Value: 'a', Count: 5}, {Value: 'b', Count: 2}, {Value: 'r', Count: 2}}
+		if !slices.Equal(top, expected) {
+			t.Errorf("Expected %v, got %v", expected, top)
+		}
+
+		all := c.MostCommon(0)
+		if len(all) != 5 || all[4] != (set.CountEntry[rune]{Value: 'd', Count: 1}) {
+			t.Errorf("MostCommon(0) should return every element, got %v", all)
+		}
+		if len(set.NewCounter[int]().MostCommon(3)) != 0 {
+			t.Error("MostCommon on empty counter should be empty")
+		}
+	})
+
+	t.Run("Arithmetic", func(t *testing.T) {
+		a := set.NewCounter[string]()
+		a.Add("x", 3)
+		a.Add("y", 1)
+		b := set.NewCounter[string]()
+		b.Add("x", 1)
+		b.Add("y", 2)
+		b.Add("z", 4)
+
+		check := func(name string, got *set.Counter[string], want string) {
+			if got.String() != want {
+				t.Errorf("%s: expected %s, got %s", name, want, got.String())
+			}
+		}
+		check("Plus", a.Plus(b), "[x:4 y:3 z:4]")
+		check("Minus", a.Minus(b), "[x:2]")
+		check("Union", a.Union(b), "[x:3 y:2 z:4]")
+		check("Intersection", a.Intersection(b), "[x:1 y:1]")
+
+		a.Subtract(b)
+		check("Subtract", a, "[x:2 y:-1 z:-4]")
+		a.Update(b)
+		check("Update", a, "[x:3 y:1]")
+	})
+
+	t.Run("Iteration", func(t *testing.T) {
+		c := set.FromCounterSlice([]int{3, 1, 3})
+		var items, counts []int
+		for item, count := range c.All() {
+			items = append(items, item)
+			counts = append(counts, count)
+		}
+		if !slices.Equal(items, []int{3, 1}) || !slices.Equal(counts, []int{2, 1}) {
+			t.Errorf("Unexpected iteration %v / %v", items, counts)
+		}
+	})
+}
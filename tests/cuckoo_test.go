@@ -0,0 +1,220 @@
+package tests
+
+import (
+	"errors"
+	"hash/maphash"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/probabilistic"
+)
+
+func TestCuckooFilter(t *testing.T) {
+	t.Run("Insert, Lookup and Delete", func(t *testing.T) {
+		f := probabilistic.NewCuckooFilter[int](10000, 12, 4)
+		if f.FingerprintBits() != 12 || f.BucketSize() != 4 || f.Capacity() != 16384 {
+			t.Errorf("Unexpected geometry %d bits, %d per bucket, %d slots",
+				f.FingerprintBits(), f.BucketSize(), f.Capacity())
+		}
+
+		for i := range 10000 {
+			if err := f.Insert(i); err != nil {
+				t.Fatalf("Unexpected error inserting %d: %v", i, err)
+			}
+		}
+		if f.Size() != 10000 || math.Abs(f.LoadFactor()-10000.0/16384) > 1e-9 {
+			t.Errorf("Expected 10000 items, got %d at load %v", f.Size(), f.LoadFactor())
+		}
+		for i := range 10000 {
+			if !f.Lookup(i) {
+				t.Fatalf("Cuckoo filter must not report false negatives, missed %d", i)
+			}
+		}
+
+		falsePositives := 0
+		for i := 10000; i < 110000; i++ {
+			if f.Lookup(i) {
+				falsePositives++
+			}
+		}
+		// At most 2*4/2^12, about 0.2%, and less below full load
+		if rate := float64(falsePositives) / 100000; rate > 0.004 {
+			t.Errorf("False-positive rate %.4f is too high", rate)
+		}
+
+		for i := 0; i < 10000; i += 2 {
+			if !f.Delete(i) {
+				t.Fatalf("Delete of %d should succeed", i)
+			}
+		}
+		for i := 1; i < 10000; i += 2 {
+			if !f.Lookup(i) {
+				t.Fatalf("Deletion removed unrelated item %d", i)
+			}
+		}
+		if f.Size() != 5000 {
+			t.Errorf("Expected 5000 items after deleting half, got %d", f.Size())
+		}
+
+		f.Clear()
+		if !f.IsEmpty() || f.Lookup(1) || f.LoadFactor() != 0 {
+			t.Error("Filter should be empty after Clear")
+		}
+	})
+
+	t.Run("Duplicates", func(t *testing.T) {
+		f := probabilistic.NewCuckooFilter[string](100, 16, 4)
+		f.Insert("a")
+		f.Insert("a")
+		f.Insert("b")
+		if f.Count("a") != 2 || f.Count("b") != 1 || f.Count("c") != 0 {
+			t.Errorf("Expected counts 2, 1 and 0, got %d, %d and %d", f.Count("a"), f.Count("b"), f.Count("c"))
+		}
+
+		if !f.Delete("a") || f.Count("a") != 1 || !f.Lookup("a") {
+			t.Error("One of two copies should remain after Delete")
+		}
+		if !f.Delete("a") || f.Lookup("a") || f.Count("a") != 0 {
+			t.Error("a should be gone after deleting both copies")
+		}
+		if f.Delete("c") {
+			t.Error("Deleting an absent item should return false")
+		}
+	})
+
+	t.Run("Full", func(t *testing.T) {
+		f := probabilistic.NewCuckooFilter[int](8, 16, 2)
+		f.Seed(1)
+		// Copies of one item can only fill its two buckets, or one if they coincide
+		copies := 0
+		var err error
+		for err = f.Insert(7); err == nil; err = f.Insert(7) {
+			copies++
+		}
+		if !errors.Is(err, probabilistic.ErrFilterFull) {
+			t.Errorf("Expected ErrFilterFull, got %v", err)
+		}
+		if copies != 2 && copies != 4 {
+			t.Fatalf("Expected room for 2 or 4 copies, got %d", copies)
+		}
+		if f.Size() != copies || f.Count(7) != copies {
+			t.Errorf("A failed insert should leave the filter unchanged, got %d items", f.Size())
+		}
+
+		inserted := 0
+		for i := 100; f.Insert(i) == nil; i++ {
+			inserted++
+		}
+		for i := 100; i < 100+inserted; i++ {
+			if !f.Lookup(i) {
+				t.Fatalf("Failed insert evicted %d", i)
+			}
+		}
+		if !f.Lookup(7) || f.Size() != copies+inserted || f.LoadFactor() > 1 {
+			t.Errorf("Unexpected state after filling: %d items at load %v", f.Size(), f.LoadFactor())
+		}
+	})
+
+	t.Run("Serialization", func(t *testing.T) {
+		seed := maphash.MakeSeed()
+		hash := func(s string) uint64 { return maphash.String(seed, s) }
+		f := probabilistic.NewCuckooFilterFunc(1000, 13, 4, hash)
+		for i := range 1000 {
+			f.Insert("user-" + strconv.Itoa(i))
+		}
+
+		data, err := f.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		loaded := probabilistic.NewCuckooFilterFunc(1, 4, 1, hash)
+		if err := loaded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if loaded.Capacity() != f.Capacity() || loaded.FingerprintBits() != 13 ||
+			loaded.BucketSize() != 4 || loaded.Size() != 1000 {
+			t.Error("Decoded filter should adopt the encoded geometry and contents")
+		}
+		for i := range 1000 {
+			if !loaded.Lookup("user-" + strconv.Itoa(i)) {
+				t.Fatalf("Decoded filter lost user-%d", i)
+			}
+		}
+
+		if err := loaded.UnmarshalBinary(data[:20]); err == nil {
+			t.Error("Truncated data should be rejected")
+		}
+		if loaded.Size() != 1000 {
+			t.Error("Failed decoding should leave the filter unchanged")
+		}
+	})
+
+	t.Run("Default Filter Serialization", func(t *testing.T) {
+		f := probabilistic.NewCuckooFilter[string](1000, 12, 4)
+		f.Seed(42)
+		for i := range 1000 {
+			f.Insert("user-" + strconv.Itoa(i))
+		}
+		data, _ := f.MarshalBinary()
+
+		// A fresh default filter adopts the encoded seed
+		loaded := probabilistic.NewCuckooFilter[string](1, 4, 1)
+		if err := loaded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		for i := range 1000 {
+			if !loaded.Lookup("user-" + strconv.Itoa(i)) {
+				t.Fatalf("Decoded filter lost user-%d", i)
+			}
+		}
+
+		custom := probabilistic.NewCuckooFilterFunc(1, 4, 1, func(s string) uint64 { return uint64(len(s)) })
+		if err := custom.UnmarshalBinary(data); err == nil {
+			t.Error("Data from a default filter should not decode into a custom-hash filter")
+		}
+
+		type point struct{ x, y int }
+		p := probabilistic.NewCuckooFilter[point](10, 12, 4)
+		p.Insert(point{1, 2})
+		data, _ = p.MarshalBinary()
+		loadedPoints := probabilistic.NewCuckooFilter[point](10, 12, 4)
+		if err := loadedPoints.UnmarshalBinary(data); err != nil || !loadedPoints.Lookup(point{1, 2}) {
+			t.Errorf("Filters in the same process should decode each other, got %v", err)
+		}
+	})
+
+	t.Run("Seed", func(t *testing.T) {
+		a := probabilistic.NewCuckooFilter[int](1000, 12, 4)
+		b := probabilistic.NewCuckooFilter[int](1000, 12, 4)
+		a.Seed(7)
+		b.Seed(7)
+		for i := range 1000 {
+			a.Insert(i)
+			b.Insert(i)
+		}
+		da, _ := a.MarshalBinary()
+		db, _ := b.MarshalBinary()
+		if string(da) != string(db) {
+			t.Error("Filters with the same seed should encode identically")
+		}
+
+		a.Seed(8)
+		if !a.IsEmpty() {
+			t.Error("Seed should clear the filter")
+		}
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		for _, params := range [][3]int{{0, 8, 4}, {10, 3, 4}, {10, 33, 4}, {10, 8, 0}, {10, 8, 9}} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Parameters %v should panic", params)
+					}
+				}()
+				probabilistic.NewCuckooFilter[int](params[0], params[1], params[2])
+			}()
+		}
+	})
+}
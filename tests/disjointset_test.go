@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/set"
+)
+
+func TestIntDisjointSet(t *testing.T) {
+	t.Run("Union and Find", func(t *testing.T) {
+		d := set.NewIntDisjointSet(6)
+		if d.Count() != 6 || d.Size() != 6 {
+			t.Errorf("Expected 6 singleton groups, got %d", d.Count())
+		}
+
+		if !d.Union(0, 1) || !d.Union(2, 3) || !d.Union(1, 3) {
+			t.Error("Union of separate groups should return true")
+		}
+		if d.Union(0, 2) {
+			t.Error("Union within a group should return false")
+		}
+
+		if !d.Connected(0, 3) || d.Connected(0, 4) {
+			t.Error("Connected disagrees with unions")
+		}
+		if d.Find(0) != d.Find(2) {
+			t.Error("Elements of one group should share a representative")
+		}
+		if d.SetSize(3) != 4 || d.SetSize(5) != 1 {
+			t.Errorf("Unexpected group sizes %d and %d", d.SetSize(3), d.SetSize(5))
+		}
+		if d.Count() != 3 {
+			t.Errorf("Expected 3 groups, got %d", d.Count())
+		}
+	})
+
+	t.Run("Groups and Add", func(t *testing.T) {
+		d := set.NewIntDisjointSet(5)
+		d.Union(4, 1)
+		d.Union(3, 0)
+
+		x := d.Add()
+		d.Union(x, 2)
+		if x != 5 || d.Size() != 6 || d.Count() != 3 {
+			t.Errorf("Add should append element 5, got %d with %d groups", x, d.Count())
+		}
+
+		var groups [][]int
+		for group := range d.Groups() {
+			groups = append(groups, group)
+		}
+		expected := [][]int{{0, 3}, {1, 4}, {2, 5}}
+		if !slices.EqualFunc(groups, expected, slices.Equal) {
+			t.Errorf("Expected %v, got %v", expected, groups)
+		}
+		if d.String() != "[[0 3] [1 4] [2 5]]" {
+			t.Errorf("Unexpected string %s", d.String())
+		}
+	})
+
+	t.Run("Long Chain", func(t *testing.T) {
+		n := 10000
+		d := set.NewIntDisjointSet(n)
+		for i := 1; i < n; i++ {
+			d.Union(i-1, i)
+		}
+		if d.Count() != 1 || d.SetSize(0) != n || !d.Connected(0, n-1) {
+			t.Error("Chained unions should produce a single group")
+		}
+	})
+
+	t.Run("Invalid Size", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Negative size should panic")
+			}
+		}()
+		set.NewIntDisjointSet(-1)
+	})
+}
+
+func TestDisjointSet(t *testing.T) {
+	t.Run("Account Merging", func(t *testing.T) {
+		d := set.NewDisjointSet[string]()
+		d.Union("alice@a.com", "alice@b.com")
+		d.Union("bob@a.com", "bob@b.com")
+		d.Union("alice@b.com", "alice@c.com")
+		d.Add("carol@a.com")
+
+		if d.Size() != 6 || d.Count() != 3 {
+			t.Errorf("Expected 6 elements in 3 groups, got %d in %d", d.Size(), d.Count())
+		}
+		if !d.Connected("alice@a.com", "alice@c.com") || d.Connected("alice@a.com", "bob@a.com") {
+			t.Error("Connected disagrees with unions")
+		}
+		if d.Connected("alice@a.com", "dave@a.com") {
+			t.Error("Unknown elements should not be connected")
+		}
+		if d.SetSize("alice@c.com") != 3 || d.SetSize("dave@a.com") != 0 {
+			t.Error("Unexpected group sizes")
+		}
+
+		rep, ok := d.Find("alice@c.com")
+		if other, _ := d.Find("alice@a.com"); !ok || rep != other {
+			t.Error("Elements of one group should share a representative")
+		}
+		if _, ok := d.Find("dave@a.com"); ok {
+			t.Error("Find should report unknown elements")
+		}
+
+		expected := "[[alice@a.com alice@b.com alice@c.com] [bob@a.com bob@b.com] [carol@a.com]]"
+		if d.String() != expected {
+			t.Errorf("Expected %s, got %s", expected, d.String())
+		}
+	})
+
+	t.Run("Add and Clear", func(t *testing.T) {
+		d := set.FromDisjointSetSlice([]int{1, 2, 3})
+		if d.Add(2) || !d.Contains(3) || d.Count() != 3 {
+			t.Error("FromDisjointSetSlice should create singleton groups")
+		}
+		if d.Union(1, 1) {
+			t.Error("Union of an element with itself should return false")
+		}
+
+		d.Clear()
+		if !d.IsEmpty() || d.Count() != 0 || d.String() != "[]" {
+			t.Error("Disjoint set should be empty after Clear")
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/rangequery"
+)
+
+func TestFenwick(t *testing.T) {
+	t.Run("Prefix and Range Sums", func(t *testing.T) {
+		f := rangequery.FromFenwickSlice([]int{3, 2, -1, 6, 5, 4, -3, 3})
+
+		if sum, err := f.PrefixSum(4); err != nil || sum != 10 {
+			t.Errorf("Expected prefix sum 10, got %d", sum)
+		}
+		if sum, _ := f.PrefixSum(0); sum != 0 {
+			t.Errorf("Empty prefix should sum to 0, got %d", sum)
+		}
+		if sum, _ := f.RangeSum(2, 6); sum != 14 {
+			t.Errorf("Expected range sum 14, got %d", sum)
+		}
+
+		f.Add(2, 4)
+		f.Set(7, 0)
+		if v, _ := f.Get(2); v != 3 {
+			t.Errorf("Expected 3 at index 2, got %d", v)
+		}
+		if !slices.Equal(f.ToSlice(), []int{3, 2, 3, 6, 5, 4, -3, 0}) {
+			t.Errorf("Unexpected contents %v", f.ToSlice())
+		}
+		if f.Size() != 8 {
+			t.Errorf("Expected size 8, got %d", f.Size())
+		}
+	})
+
+	t.Run("LowerBound", func(t *testing.T) {
+		f := rangequery.FromFenwickSlice([]int{1, 0, 2, 3, 0, 4})
+		cases := map[int]int{-5: 0, 0: 0, 1: 0, 2: 2, 3: 2, 4: 3, 6: 3, 7: 5, 10: 5, 11: 6}
+		for target, expected := range cases {
+			if got := f.LowerBound(target); got != expected {
+				t.Errorf("LowerBound(%d): expected %d, got %d", target, expected, got)
+			}
+		}
+		if rangequery.NewFenwick[int](0).LowerBound(1) != 0 {
+			t.Error("LowerBound on an empty tree should be 0")
+		}
+	})
+
+	t.Run("Bounds", func(t *testing.T) {
+		f := rangequery.NewFenwick[float64](3)
+		if err := f.Add(3, 1); err == nil {
+			t.Error("Add out of bounds should fail")
+		}
+		if _, err := f.PrefixSum(4); err == nil {
+			t.Error("PrefixSum out of bounds should fail")
+		}
+		if _, err := f.RangeSum(2, 1); err == nil {
+			t.Error("Inverted range should fail")
+		}
+	})
+}
+
+func TestRangeFenwick(t *testing.T) {
+	f := rangequery.FromRangeFenwickSlice([]int{1, 2, 3, 4, 5})
+
+	f.AddRange(1, 4, 10)
+	if sum, _ := f.RangeSum(0, 5); sum != 45 {
+		t.Errorf("Expected sum 45, got %d", sum)
+	}
+	f.AddRange(0, 2, -1)
+	f.Add(4, 2)
+	if !slices.Equal(f.ToSlice(), []int{0, 11, 13, 14, 7}) {
+		t.Errorf("Unexpected contents %v", f.ToSlice())
+	}
+	if sum, _ := f.PrefixSum(3); sum != 24 {
+		t.Errorf("Expected prefix sum 24, got %d", sum)
+	}
+	if v, _ := f.Get(2); v != 13 {
+		t.Errorf("Expected 13 at index 2, got %d", v)
+	}
+	if err := f.AddRange(3, 6, 1); err == nil {
+		t.Error("AddRange out of bounds should fail")
+	}
+	if f.String() != "[0 11 13 14 7]" {
+		t.Errorf("Unexpected string %s", f.String())
+	}
+}
+
+func TestFenwick2D(t *testing.T) {
+	f := rangequery.NewFenwick2D[int](3, 4)
+	grid := [][]int{
+		{1, 2, 3, 4},
+		{5, 6, 7, 8},
+		{9, 10, 11, 12},
+	}
+	for r, row := range grid {
+		for c, v := range row {
+			f.Add(r, c, v)
+		}
+	}
+
+	if sum, _ := f.PrefixSum(2, 2); sum != 14 {
+		t.Errorf("Expected prefix sum 14, got %d", sum)
+	}
+	if sum, _ := f.RangeSum(1, 1, 3, 3); sum != 34 {
+		t.Errorf("Expected rectangle sum 34, got %d", sum)
+	}
+	f.Add(1, 2, -7)
+	if v, _ := f.Get(1, 2); v != 0 {
+		t.Errorf("Expected 0 at (1, 2), got %d", v)
+	}
+	if sum, _ := f.RangeSum(0, 0, 3, 4); sum != 71 {
+		t.Errorf("Expected total 71, got %d", sum)
+	}
+	if f.Rows() != 3 || f.Cols() != 4 {
+		t.Errorf("Expected 3x4 grid, got %dx%d", f.Rows(), f.Cols())
+	}
+	if err := f.Add(3, 0, 1); err == nil {
+		t.Error("Add out of bounds should fail")
+	}
+	if _, err := f.RangeSum(0, 2, 1, 1); err == nil {
+		t.Error("Inverted rectangle should fail")
+	}
+}
@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/graph"
+)
+
+func TestGraph(t *testing.T) {
+	t.Run("Directed Edges", func(t *testing.T) {
+		g := graph.NewDirectedGraph[string, int]()
+		if !g.AddEdge("a", "b", 1) || !g.AddEdge("a", "c", 2) || !g.AddEdge("c", "b", 3) {
+			t.Error("AddEdge should report new edges")
+		}
+		if g.AddEdge("a", "b", 5) {
+			t.Error("AddEdge should report replaced edges")
+		}
+		if w, ok := g.Weight("a", "b"); !ok || w != 5 {
+			t.Errorf("Expected replaced weight 5, got %d", w)
+		}
+
+		if !g.IsDirected() || g.VertexCount() != 3 || g.EdgeCount() != 3 {
+			t.Errorf("Expected 3 vertices and 3 edges, got %d and %d", g.VertexCount(), g.EdgeCount())
+		}
+		if !g.HasEdge("c", "b") || g.HasEdge("b", "c") {
+			t.Error("Directed edges should only go one way")
+		}
+		if g.OutDegree("a") != 2 || g.InDegree("b") != 2 || g.InDegree("a") != 0 {
+			t.Error("Unexpected degrees")
+		}
+
+		preds := slices.Collect(maps.Keys(maps.Collect(g.Predecessors("b"))))
+		slices.Sort(preds)
+		if !slices.Equal(preds, []string{"a", "c"}) {
+			t.Errorf("Expected predecessors [a c], got %v", preds)
+		}
+		if g.String() != "[a:[b c] b:[] c:[b]]" {
+			t.Errorf("Unexpected string %s", g.String())
+		}
+
+		if !g.RemoveVertex("c") || g.RemoveVertex("c") {
+			t.Error("RemoveVertex should report presence correctly")
+		}
+		if g.EdgeCount() != 1 || g.InDegree("b") != 1 {
+			t.Errorf("RemoveVertex should remove incident edges, %d left", g.EdgeCount())
+		}
+		if !g.RemoveEdge("a", "b") || g.RemoveEdge("a", "b") || g.EdgeCount() != 0 {
+			t.Error("RemoveEdge should report presence correctly")
+		}
+
+		g.Clear()
+		if !g.IsEmpty() || g.String() != "[]" {
+			t.Error("Graph should be empty after Clear")
+		}
+	})
+
+	t.Run("Undirected Edges", func(t *testing.T) {
+		g := graph.NewUndirectedGraph[int, float64]()
+		g.AddEdge(1, 2, 0.5)
+		g.AddEdge(2, 3, 1.5)
+		g.AddEdge(3, 3, 2)
+
+		if !g.HasEdge(2, 1) || g.EdgeCount() != 3 {
+			t.Errorf("Undirected edges should go both ways, got %d edges", g.EdgeCount())
+		}
+
+		var edges []graph.Edge[int, float64]
+		for e := range g.Edges() {
+			edges = append(edges, e)
+		}
+		expected := []graph.Edge[int, float64]{
+			{From: 1, To: 2, Weight: 0.5},
+			{From: 2, To: 3, Weight: 1.5},
+			{From: 3, To: 3, Weight: 2},
+		}
+		if !slices.Equal(edges, expected) {
+			t.Errorf("Expected %v, got %v", expected, edges)
+		}
+
+		g.RemoveEdge(2, 1)
+		if g.HasEdge(1, 2) || g.EdgeCount() != 2 {
+			t.Error("Removing an undirected edge should remove both directions")
+		}
+		g.RemoveVertex(3)
+		if g.EdgeCount() != 0 || g.OutDegree(2) != 0 {
+			t.Error("RemoveVertex should remove the self-loop and incident edges")
+		}
+	})
+}
+
+func TestGraphTraversal(t *testing.T) {
+	g := graph.NewDirectedGraph[int, int]()
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(1, 3, 1)
+	g.AddEdge(2, 4, 1)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(4, 5, 1)
+	g.AddEdge(6, 5, 1)
+	g.AddVertex(7)
+
+	t.Run("BFS and DFS", func(t *testing.T) {
+		if bfs := slices.Collect(g.BFS(1)); !slices.Equal(bfs, []int{1, 2, 3, 4, 5}) {
+			t.Errorf("Unexpected BFS order %v", bfs)
+		}
+		if dfs := slices.Collect(g.DFS(1)); !slices.Equal(dfs, []int{1, 2, 4, 5, 3}) {
+			t.Errorf("Unexpected DFS order %v", dfs)
+		}
+		if len(slices.Collect(g.BFS(99))) != 0 {
+			t.Error("Traversal from an unknown vertex should be empty")
+		}
+		for v := range g.DFS(1) {
+			if v == 4 {
+				break
+			}
+		}
+	})
+
+	t.Run("Hop Paths", func(t *testing.T) {
+		path, ok := g.ShortestHopPath(1, 5)
+		if !ok || !slices.Equal(path, []int{1, 2, 4, 5}) {
+			t.Errorf("Expected [1 2 4 5], got %v", path)
+		}
+		if path, ok := g.ShortestHopPath(3, 3); !ok || !slices.Equal(path, []int{3}) {
+			t.Errorf("Path to self should be [3], got %v", path)
+		}
+		if _, ok := g.ShortestHopPath(5, 1); ok {
+			t.Error("5 cannot reach 1 in a directed graph")
+		}
+
+		dist := g.HopDistances(1)
+		if len(dist) != 5 || dist[4] != 2 || dist[5] != 3 {
+			t.Errorf("Unexpected hop distances %v", dist)
+		}
+	})
+
+	t.Run("Connected Components", func(t *testing.T) {
+		components := g.ConnectedComponents()
+		expected := [][]int{{1, 2, 3, 4, 5, 6}, {7}}
+		if !slices.EqualFunc(components, expected, func(a, b []int) bool {
+			a = slices.Clone(a)
+			slices.Sort(a)
+			return slices.Equal(a, b)
+		}) {
+			t.Errorf("Expected %v, got %v", expected, components)
+		}
+	})
+
+	t.Run("Zero One BFS", func(t *testing.T) {
+		h := graph.NewDirectedGraph[string, int]()
+		h.AddEdge("s", "a", 1)
+		h.AddEdge("s", "b", 1)
+		h.AddEdge("a", "c", 1)
+		h.AddEdge("b", "d", 0)
+		h.AddEdge("d", "c", 0)
+
+		dist, err := graph.ZeroOneBFS(h, "s")
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		expected := map[string]int{"s": 0, "a": 1, "b": 1, "c": 1, "d": 1}
+		if !maps.Equal(dist, expected) {
+			t.Errorf("Expected %v, got %v", expected, dist)
+		}
+
+		h.AddEdge("c", "e", 2)
+		if _, err := graph.ZeroOneBFS(h, "s"); err == nil {
+			t.Error("Weights other than 0 and 1 should be rejected")
+		}
+	})
+}
@@ -0,0 +1,153 @@
+package tests
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/graph"
+)
+
+// buildGraph creates a directed graph from a list of dependency pairs
+func buildGraph(edges [][2]string) *graph.Graph[string, struct{}] {
+	g := graph.NewDirectedGraph[string, struct{}]()
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1], struct{}{})
+	}
+	return g
+}
+
+func TestTopologicalSort(t *testing.T) {
+	t.Run("Kahn", func(t *testing.T) {
+		g := buildGraph([][2]string{
+			{"fetch", "compile"}, {"configure", "compile"}, {"compile", "link"},
+			{"compile", "test"}, {"link", "package"}, {"test", "package"},
+		})
+		order, err := g.TopologicalSort()
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		expected := []string{"fetch", "configure", "compile", "link", "test", "package"}
+		if !slices.Equal(order, expected) {
+			t.Errorf("Expected %v, got %v", expected, order)
+		}
+
+		g.AddEdge("package", "fetch", struct{}{})
+		if _, err := g.TopologicalSort(); err == nil {
+			t.Error("Cyclic graph should be rejected")
+		}
+		if _, err := graph.NewUndirectedGraph[int, int]().TopologicalSort(); err == nil {
+			t.Error("Undirected graph should be rejected")
+		}
+	})
+
+	t.Run("Lexicographic", func(t *testing.T) {
+		g := graph.NewDirectedGraph[int, int]()
+		for _, v := range []int{5, 4, 3, 2, 1} {
+			g.AddVertex(v)
+		}
+		g.AddEdge(5, 1, 0)
+		g.AddEdge(4, 1, 0)
+		g.AddEdge(3, 2, 0)
+
+		order, err := graph.LexicographicTopologicalSort(g)
+		if err != nil || !slices.Equal(order, []int{3, 2, 4, 5, 1}) {
+			t.Errorf("Expected [3 2 4 5 1], got %v (%v)", order, err)
+		}
+
+		g.AddEdge(1, 4, 0)
+		if _, err := graph.LexicographicTopologicalSort(g); err == nil {
+			t.Error("Cyclic graph should be rejected")
+		}
+	})
+}
+
+func TestFindCycle(t *testing.T) {
+	t.Run("Directed", func(t *testing.T) {
+		g := buildGraph([][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"a", "d"}})
+		if cycle, ok := g.FindCycle(); ok || g.HasCycle() {
+			t.Errorf("Diamond has no cycle, got %v", cycle)
+		}
+
+		g.AddEdge("d", "b", struct{}{})
+		cycle, ok := g.FindCycle()
+		if !ok || !slices.Equal(cycle, []string{"b", "c", "d"}) {
+			t.Errorf("Expected cycle [b c d], got %v", cycle)
+		}
+		for i, v := range cycle {
+			if !g.HasEdge(v, cycle[(i+1)%len(cycle)]) {
+				t.Errorf("Cycle %v is missing edge from %s", cycle, v)
+			}
+		}
+
+		g.AddEdge("e", "e", struct{}{})
+		g.RemoveEdge("d", "b")
+		if cycle, _ := g.FindCycle(); !slices.Equal(cycle, []string{"e"}) {
+			t.Errorf("Self-loop should be a cycle, got %v", cycle)
+		}
+	})
+
+	t.Run("Undirected", func(t *testing.T) {
+		g := graph.NewUndirectedGraph[int, int]()
+		g.AddEdge(1, 2, 0)
+		g.AddEdge(2, 3, 0)
+		g.AddEdge(3, 4, 0)
+		if g.HasCycle() {
+			t.Error("A path is not a cycle")
+		}
+
+		g.AddEdge(4, 2, 0)
+		if cycle, ok := g.FindCycle(); !ok || !slices.Equal(cycle, []int{2, 3, 4}) {
+			t.Errorf("Expected cycle [2 3 4], got %v", cycle)
+		}
+	})
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	g := buildGraph([][2]string{
+		{"a", "b"}, {"b", "c"}, {"c", "a"},
+		{"b", "d"}, {"d", "e"}, {"e", "f"}, {"f", "d"},
+		{"g", "f"}, {"g", "h"}, {"h", "g"},
+	})
+	g.AddVertex("i")
+
+	normalize := func(components [][]string) [][]string {
+		var result [][]string
+		for _, c := range components {
+			c = slices.Clone(c)
+			slices.Sort(c)
+			result = append(result, c)
+		}
+		return result
+	}
+
+	tarjan := normalize(g.TarjanSCC())
+	expected := [][]string{{"d", "e", "f"}, {"a", "b", "c"}, {"g", "h"}, {"i"}}
+	if !slices.EqualFunc(tarjan, expected, slices.Equal) {
+		t.Errorf("Tarjan: expected %v, got %v", expected, tarjan)
+	}
+
+	kosaraju := normalize(g.KosarajuSCC())
+	slices.SortFunc(kosaraju, func(a, b []string) int { return len(b) - len(a) })
+	slices.SortFunc(tarjan, func(a, b []string) int { return len(b) - len(a) })
+	if len(kosaraju) != 4 {
+		t.Fatalf("Kosaraju: expected 4 components, got %v", kosaraju)
+	}
+	for _, c := range kosaraju {
+		if !slices.ContainsFunc(tarjan, func(o []string) bool { return slices.Equal(c, o) }) {
+			t.Errorf("Kosaraju component %v not found by Tarjan", c)
+		}
+	}
+
+	// Kosaraju lists components so that edges between them point forward
+	position := make(map[string]int)
+	for i, c := range g.KosarajuSCC() {
+		for _, v := range c {
+			position[v] = i
+		}
+	}
+	for e := range g.Edges() {
+		if position[e.From] > position[e.To] {
+			t.Errorf("Edge %s->%s points backward between components", e.From, e.To)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/tree"
+)
+
+func TestIntervalTree(t *testing.T) {
+	t.Run("Bookings", func(t *testing.T) {
+		it := tree.NewIntervalTree[int, string]()
+		it.Insert(9, 10, "standup")
+		it.Insert(13, 15, "review")
+		it.Insert(10, 12, "design")
+		it.Insert(14, 14, "call")
+		it.Insert(8, 17, "on-call")
+
+		if it.Insert(9, 10, "sync") || it.Size() != 5 {
+			t.Error("Inserting an existing interval should replace its value")
+		}
+		if v, ok := it.Get(9, 10); !ok || v != "sync" {
+			t.Errorf("Expected sync, got %s", v)
+		}
+		if it.Insert(5, 4, "invalid") {
+			t.Error("Inverted intervals should be ignored")
+		}
+
+		var names []string
+		for _, v := range it.Overlapping(12, 13) {
+			names = append(names, v)
+		}
+		if !slices.Equal(names, []string{"on-call", "design", "review"}) {
+			t.Errorf("Unexpected overlaps %v", names)
+		}
+
+		names = nil
+		for iv, v := range it.Containing(14) {
+			names = append(names, v)
+			if iv.Lo > 14 || iv.Hi < 14 {
+				t.Errorf("Interval %v does not contain 14", iv)
+			}
+		}
+		if !slices.Equal(names, []string{"on-call", "review", "call"}) {
+			t.Errorf("Unexpected stabbing result %v", names)
+		}
+
+		if it.Overlaps(18, 20) || !it.Overlaps(17, 20) {
+			t.Error("Overlaps disagrees with the closed interval bounds")
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		it := tree.NewIntervalTree[int, int]()
+		for i := range 100 {
+			it.Insert(i, i+10, i)
+		}
+		for i := 0; i < 100; i += 2 {
+			if !it.Delete(i, i+10) {
+				t.Errorf("Delete of [%d %d] should succeed", i, i+10)
+			}
+		}
+		if it.Delete(0, 10) || it.Size() != 50 {
+			t.Errorf("Expected 50 intervals left, got %d", it.Size())
+		}
+
+		count := 0
+		for iv := range it.Containing(50) {
+			if iv.Lo%2 == 0 {
+				t.Errorf("Deleted interval %v was returned", iv)
+			}
+			count++
+		}
+		if count != 5 {
+			t.Errorf("Expected 5 intervals containing 50, got %d", count)
+		}
+
+		it.Clear()
+		if !it.IsEmpty() || it.String() != "[]" {
+			t.Error("Tree should be empty after Clear")
+		}
+	})
+
+	t.Run("String and Order", func(t *testing.T) {
+		it := tree.NewIntervalTree[int, string]()
+		it.Insert(3, 5, "b")
+		it.Insert(1, 8, "a")
+		it.Insert(3, 4, "c")
+		if it.String() != "[[1 8]:a [3 4]:c [3 5]:b]" {
+			t.Errorf("Unexpected string %s", it.String())
+		}
+	})
+}
+
+func TestIntervalSet(t *testing.T) {
+	t.Run("Merging", func(t *testing.T) {
+		s := tree.NewIntervalSet[int]()
+		s.Add(10, 20)
+		s.Add(30, 40)
+		s.Add(20, 25)
+		if s.String() != "[[10 25) [30 40)]" {
+			t.Errorf("Adjacent ranges should merge, got %s", s.String())
+		}
+
+		s.Add(24, 31)
+		if s.String() != "[[10 40)]" || s.Size() != 1 {
+			t.Errorf("Overlapping ranges should merge, got %s", s.String())
+		}
+		s.Add(12, 15)
+		s.Add(50, 50)
+		if s.Size() != 1 {
+			t.Errorf("Contained and empty ranges should not add ranges, got %s", s.String())
+		}
+		s.Add(0, 100)
+		if s.String() != "[[0 100)]" {
+			t.Errorf("Covering range should absorb everything, got %s", s.String())
+		}
+	})
+
+	t.Run("Removing", func(t *testing.T) {
+		s := tree.NewIntervalSet[int]()
+		s.Add(0, 100)
+		s.Remove(40, 60)
+		if s.String() != "[[0 40) [60 100)]" {
+			t.Errorf("Remove should split the range, got %s", s.String())
+		}
+		s.Remove(30, 70)
+		s.Remove(90, 120)
+		if s.String() != "[[0 30) [70 90)]" {
+			t.Errorf("Remove should trim both ranges, got %s", s.String())
+		}
+		s.Remove(-5, 95)
+		if !s.IsEmpty() {
+			t.Errorf("Set should be empty, got %s", s.String())
+		}
+	})
+
+	t.Run("Queries", func(t *testing.T) {
+		s := tree.NewIntervalSet[float64]()
+		s.Add(1, 2.5)
+		s.Add(4, 6)
+
+		if !s.Contains(1) || s.Contains(2.5) || s.Contains(3) || !s.Contains(5.9) {
+			t.Error("Contains disagrees with half-open ranges")
+		}
+		if !s.ContainsRange(4, 6) || s.ContainsRange(2, 4.5) || !s.ContainsRange(3, 3) {
+			t.Error("ContainsRange disagrees with the ranges")
+		}
+		if !s.Overlaps(2, 4.5) || s.Overlaps(2.5, 4) || s.Overlaps(0, 1) {
+			t.Error("Overlaps disagrees with the ranges")
+		}
+
+		var bounds []float64
+		for lo, hi := range s.All() {
+			bounds = append(bounds, lo, hi)
+		}
+		if !slices.Equal(bounds, []float64{1, 2.5, 4, 6}) {
+			t.Errorf("Unexpected ranges %v", bounds)
+		}
+
+		s.Clear()
+		if !s.IsEmpty() || s.String() != "[]" {
+			t.Error("Set should be empty after Clear")
+		}
+	})
+}
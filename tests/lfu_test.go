@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/cache"
+)
+
+func TestLFUCache(t *testing.T) {
+	t.Run("Evicts Least Frequently Used", func(t *testing.T) {
+		lfu := cache.NewLFUCache[string, int](2, nil)
+		lfu.Put("a", 1)
+		lfu.Put("b", 2)
+
+		lfu.Get("a")
+		lfu.Get("a")
+		lfu.Get("b")
+
+		if lfu.Frequency("a") != 3 || lfu.Frequency("b") != 2 {
+			t.Errorf("Expected frequencies 3 and 2, got %d and %d", lfu.Frequency("a"), lfu.Frequency("b"))
+		}
+
+		if evicted := lfu.Put("c", 3); !evicted {
+			t.Error("Put beyond capacity should evict")
+		}
+		if lfu.Contains("b") {
+			t.Error("'b' should have been evicted as least frequently used")
+		}
+		if !lfu.Contains("a") || !lfu.Contains("c") {
+			t.Error("'a' and 'c' should be cached")
+		}
+		if lfu.Frequency("missing") != 0 {
+			t.Error("Frequency of a missing key should be 0")
+		}
+	})
+
+	t.Run("Ties Evict Least Recently Used", func(t *testing.T) {
+		lfu := cache.NewLFUCache[int, int](3, nil)
+		lfu.Put(1, 1)
+		lfu.Put(2, 2)
+		lfu.Put(3, 3)
+
+		lfu.Put(4, 4)
+		if lfu.Contains(1) {
+			t.Error("Oldest of the equally used keys should be evicted")
+		}
+		if lfu.String() != "[2:2 3:3 4:4]" {
+			t.Errorf("Expected [2:2 3:3 4:4], got %s", lfu.String())
+		}
+	})
+
+	t.Run("Peek and Update", func(t *testing.T) {
+		lfu := cache.NewLFUCache[int, string](2, nil)
+		lfu.Put(1, "one")
+
+		if val, ok := lfu.Peek(1); !ok || val != "one" {
+			t.Errorf("Peek(1) should return 'one', got '%s'", val)
+		}
+		if lfu.Frequency(1) != 1 {
+			t.Errorf("Peek should not change frequency, got %d", lfu.Frequency(1))
+		}
+
+		lfu.Put(1, "uno")
+		if val, _ := lfu.Get(1); val != "uno" || lfu.Frequency(1) != 3 {
+			t.Errorf("Expected 'uno' with frequency 3, got '%s' with %d", val, lfu.Frequency(1))
+		}
+	})
+
+	t.Run("Remove, Resize and Callback", func(t *testing.T) {
+		var evicted []int
+		lfu := cache.NewLFUCache(4, func(key, value int) {
+			evicted = append(evicted, key)
+		})
+		for i := range 4 {
+			lfu.Put(i, i)
+			for range i {
+				lfu.Get(i)
+			}
+		}
+
+		if !lfu.Remove(0) || lfu.Remove(0) {
+			t.Error("Remove should report presence correctly")
+		}
+		if n := lfu.Resize(1); n != 2 {
+			t.Errorf("Resize should evict 2 entries, got %d", n)
+		}
+		if !slices.Equal(evicted, []int{1, 2}) {
+			t.Errorf("Expected evicted keys [1 2], got %v", evicted)
+		}
+		if lfu.Len() != 1 || !lfu.Contains(3) {
+			t.Error("Most frequently used key should survive Resize")
+		}
+
+		lfu.Clear()
+		if lfu.Len() != 0 || lfu.String() != "[]" {
+			t.Error("Cache should be empty after Clear")
+		}
+		lfu.Put(5, 5)
+		if lfu.Frequency(5) != 1 {
+			t.Error("Cache should be usable after Clear")
+		}
+	})
+}
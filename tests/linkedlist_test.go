@@ -329,6 +329,58 @@ func TestLinkedListFindMultipleOccurrences(t *testing.T) {
 	}
 }
 
+// TestLinkedListNodeHandles tests node-based insertion, removal and moves
+func TestLinkedListNodeHandles(t *testing.T) {
+	list := linear.NewLinkedList[int]()
+
+	if list.Front() != nil || list.Back() != nil {
+		t.Error("Empty list should have nil Front and Back")
+	}
+
+	two := list.PushBack(2)
+	one := list.PushFront(1)
+	three := list.PushBack(3)
+
+	if list.Front() != one || list.Back() != three {
+		t.Error("Front and Back should return the pushed nodes")
+	}
+	if list.String() != "[1 2 3]" {
+		t.Errorf("Expected [1 2 3], got %s", list.String())
+	}
+
+	// Move middle node to both ends
+	list.MoveToFront(two)
+	if list.String() != "[2 1 3]" {
+		t.Errorf("Expected [2 1 3] after MoveToFront, got %s", list.String())
+	}
+	list.MoveToBack(two)
+	if list.String() != "[1 3 2]" {
+		t.Errorf("Expected [1 3 2] after MoveToBack, got %s", list.String())
+	}
+	list.MoveToBack(two)
+	if list.String() != "[1 3 2]" {
+		t.Errorf("MoveToBack on tail should be a no-op, got %s", list.String())
+	}
+
+	// Remove head, middle and tail
+	if value := list.Remove(three); value != 3 {
+		t.Errorf("Remove should return 3, got %d", value)
+	}
+	if list.Remove(one) != 1 || list.Front() != two || list.Back() != two {
+		t.Error("Removing head should leave single node as Front and Back")
+	}
+	list.Remove(two)
+	if !list.IsEmpty() || list.Front() != nil || list.Back() != nil {
+		t.Error("List should be empty after removing all nodes")
+	}
+
+	// List remains usable after removing every node
+	list.Append(4)
+	if list.String() != "[4]" || list.Size() != 1 {
+		t.Errorf("Expected [4], got %s", list.String())
+	}
+}
+
 // BenchmarkLinkedListGet benchmarks the Get operation
 func BenchmarkLinkedListGet(b *testing.B) {
 	list := linear.NewLinkedList[int]()
@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/cache"
+)
+
+func TestLRUCache(t *testing.T) {
+	t.Run("Basic Operations", func(t *testing.T) {
+		lru := cache.NewLRUCache[string, int](2, nil)
+
+		if lru.Len() != 0 {
+			t.Errorf("New cache should be empty, got %d entries", lru.Len())
+		}
+
+		lru.Put("a", 1)
+		lru.Put("b", 2)
+
+		val, ok := lru.Get("a")
+		if !ok || val != 1 {
+			t.Errorf("Get('a') should return 1, got %d", val)
+		}
+
+		// "b" is now least recently used and should be evicted
+		if evicted := lru.Put("c", 3); !evicted {
+			t.Error("Put beyond capacity should evict")
+		}
+		if lru.Contains("b") {
+			t.Error("'b' should have been evicted")
+		}
+		if !slices.Equal(lru.Keys(), []string{"c", "a"}) {
+			t.Errorf("Expected keys [c a], got %v", lru.Keys())
+		}
+
+		// Updating an existing key does not evict
+		if evicted := lru.Put("a", 10); evicted {
+			t.Error("Updating an existing key should not evict")
+		}
+		if val, _ := lru.Get("a"); val != 10 {
+			t.Errorf("Get('a') should return 10, got %d", val)
+		}
+
+		if _, ok := lru.Get("missing"); ok {
+			t.Error("Get should report missing keys")
+		}
+	})
+
+	t.Run("Peek Does Not Touch", func(t *testing.T) {
+		lru := cache.NewLRUCache[int, int](2, nil)
+		lru.Put(1, 1)
+		lru.Put(2, 2)
+
+		if val, ok := lru.Peek(1); !ok || val != 1 {
+			t.Errorf("Peek(1) should return 1, got %d", val)
+		}
+
+		lru.Put(3, 3)
+		if lru.Contains(1) {
+			t.Error("Peek should not refresh recency, 1 should be evicted")
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		evictions := 0
+		lru := cache.NewLRUCache(2, func(key, value int) { evictions++ })
+		lru.Put(1, 1)
+
+		if !lru.Remove(1) {
+			t.Error("Remove should return true for present key")
+		}
+		if lru.Remove(1) {
+			t.Error("Remove should return false for missing key")
+		}
+		if lru.Len() != 0 || evictions != 0 {
+			t.Error("Remove should delete without calling the eviction callback")
+		}
+	})
+
+	t.Run("Eviction Callback and Resize", func(t *testing.T) {
+		var evicted []int
+		lru := cache.NewLRUCache(3, func(key, value int) {
+			evicted = append(evicted, key)
+		})
+		for i := range 5 {
+			lru.Put(i, i*i)
+		}
+
+		if !slices.Equal(evicted, []int{0, 1}) {
+			t.Errorf("Expected evicted keys [0 1], got %v", evicted)
+		}
+
+		if n := lru.Resize(1); n != 2 {
+			t.Errorf("Resize should evict 2 entries, got %d", n)
+		}
+		if lru.Capacity() != 1 || lru.Len() != 1 {
+			t.Errorf("Expected capacity 1 and length 1, got %d and %d", lru.Capacity(), lru.Len())
+		}
+		if val, ok := lru.Get(4); !ok || val != 16 {
+			t.Errorf("Most recent entry should survive Resize, got %d", val)
+		}
+	})
+
+	t.Run("Clear and String", func(t *testing.T) {
+		lru := cache.NewLRUCache[string, int](3, nil)
+		lru.Put("x", 1)
+		lru.Put("y", 2)
+
+		if lru.String() != "[y:2 x:1]" {
+			t.Errorf("Expected [y:2 x:1], got %s", lru.String())
+		}
+
+		lru.Clear()
+		if lru.Len() != 0 || lru.String() != "[]" {
+			t.Error("Cache should be empty after Clear")
+		}
+	})
+
+	t.Run("Invalid Capacity", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("NewLRUCache should panic for zero capacity")
+			}
+		}()
+		cache.NewLRUCache[int, int](0, nil)
+	})
+}
+
+func TestSyncLRUCache(t *testing.T) {
+	lru := cache.NewSyncLRUCache[int, int](100, nil)
+
+	var wg sync.WaitGroup
+	for w := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				lru.Put(w*1000+i, i)
+				lru.Get(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if lru.Len() != 100 {
+		t.Errorf("Expected 100 entries, got %d", lru.Len())
+	}
+}
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/linear"
+	"github.com/abhishekR-tech/collections/set"
+)
+
+func TestMultiset(t *testing.T) {
+	t.Run("Basic Operations", func(t *testing.T) {
+		m := set.FromMultisetSlice([]string{"pear", "apple", "pear", "fig", "pear"})
+
+		if m.Size() != 5 || m.Distinct() != 3 {
+			t.Errorf("Expected 5 elements over 3 distinct, got %d over %d", m.Size(), m.Distinct())
+		}
+		if m.Count("pear") != 3 || m.Count("kiwi") != 0 {
+			t.Error("Count disagrees with inserted elements")
+		}
+
+		m.AddN("fig", 2)
+		m.AddN("kiwi", 0)
+		if m.Count("fig") != 3 || m.Contains("kiwi") {
+			t.Error("AddN should add n occurrences and ignore n <= 0")
+		}
+
+		if !m.Remove("pear") || m.Count("pear") != 2 {
+			t.Error("Remove should delete a single occurrence")
+		}
+		if n := m.RemoveN("fig", 10); n != 3 || m.Contains("fig") {
+			t.Errorf("RemoveN should cap at the multiplicity, removed %d", n)
+		}
+		if n := m.RemoveAll("pear"); n != 2 || m.Remove("pear") {
+			t.Errorf("RemoveAll should remove every occurrence, removed %d", n)
+		}
+		if m.Size() != 1 {
+			t.Errorf("Expected a single element left, got %d", m.Size())
+		}
+
+		m.Clear()
+		if !m.IsEmpty() || m.String() != "[]" {
+			t.Error("Multiset should be empty after Clear")
+		}
+	})
+
+	t.Run("Sorted Iteration", func(t *testing.T) {
+		m := set.FromMultisetSlice([]int{5, 1, 5, 3, 1, 5})
+
+		var items, counts []int
+		for item, count := range m.All() {
+			items = append(items, item)
+			counts = append(counts, count)
+		}
+		if !slices.Equal(items, []int{1, 3, 5}) || !slices.Equal(counts, []int{2, 1, 3}) {
+			t.Errorf("Unexpected multiplicities %v / %v", items, counts)
+		}
+
+		if !slices.Equal(m.ToSlice(), []int{1, 1, 3, 5, 5, 5}) {
+			t.Errorf("ToSlice should list every occurrence, got %v", m.ToSlice())
+		}
+		if m.String() != "[1:2 3:1 5:3]" {
+			t.Errorf("Expected [1:2 3:1 5:3], got %s", m.String())
+		}
+		if lo, _ := m.Min(); lo != 1 {
+			t.Errorf("Min should be 1, got %d", lo)
+		}
+		if hi, _ := m.Max(); hi != 5 {
+			t.Errorf("Max should be 5, got %d", hi)
+		}
+	})
+
+	t.Run("Custom Order and Collection", func(t *testing.T) {
+		m := set.NewMultisetFunc(func(a, b int) int { return b - a })
+		m.Add(1)
+		m.Add(2)
+		m.Add(2)
+
+		var c linear.Collection[int] = m
+		if !slices.Equal(c.ToSlice(), []int{2, 2, 1}) {
+			t.Errorf("Reverse comparator should sort descending, got %v", c.ToSlice())
+		}
+	})
+}
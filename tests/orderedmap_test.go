@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/ordered"
+)
+
+func TestOrderedMap(t *testing.T) {
+	t.Run("Insertion Order", func(t *testing.T) {
+		m := ordered.NewOrderedMap[string, int]()
+
+		if !m.IsEmpty() {
+			t.Error("New map should be empty")
+		}
+
+		for i, k := range []string{"zeta", "alpha", "mid"} {
+			if !m.Put(k, i) {
+				t.Errorf("Put(%q) should report a new key", k)
+			}
+		}
+		if m.Put("zeta", 10) {
+			t.Error("Put of an existing key should return false")
+		}
+
+		if !slices.Equal(m.Keys(), []string{"zeta", "alpha", "mid"}) {
+			t.Errorf("Updating a key should keep its place, got %v", m.Keys())
+		}
+		if !slices.Equal(m.Values(), []int{10, 1, 2}) {
+			t.Errorf("Expected values [10 1 2], got %v", m.Values())
+		}
+		if m.String() != "[zeta:10 alpha:1 mid:2]" {
+			t.Errorf("Expected [zeta:10 alpha:1 mid:2], got %s", m.String())
+		}
+
+		if v, ok := m.Get("alpha"); !ok || v != 1 {
+			t.Errorf("Get('alpha') should return 1, got %d", v)
+		}
+		if !slices.Equal(m.Keys(), []string{"zeta", "alpha", "mid"}) {
+			t.Error("Get should not reorder an insertion-ordered map")
+		}
+
+		if !m.Delete("alpha") || m.Delete("alpha") {
+			t.Error("Delete should report presence correctly")
+		}
+		m.Put("alpha", 3)
+		if !slices.Equal(m.Keys(), []string{"zeta", "mid", "alpha"}) {
+			t.Errorf("Re-inserted key should go to the end, got %v", m.Keys())
+		}
+	})
+
+	t.Run("Access Order", func(t *testing.T) {
+		m := ordered.NewAccessOrderedMap[int, string]()
+		m.Put(1, "a")
+		m.Put(2, "b")
+		m.Put(3, "c")
+
+		m.Get(1)
+		m.Put(2, "B")
+		if !slices.Equal(m.Keys(), []int{3, 1, 2}) {
+			t.Errorf("Expected access order [3 1 2], got %v", m.Keys())
+		}
+
+		if v, _ := m.Peek(3); v != "c" || !m.Contains(3) {
+			t.Error("Peek and Contains should find 3")
+		}
+		if k, _, _ := m.First(); k != 3 {
+			t.Errorf("Peek should not reorder, expected 3 first, got %d", k)
+		}
+	})
+
+	t.Run("Moves and Ends", func(t *testing.T) {
+		m := ordered.NewOrderedMap[int, int]()
+		for i := range 4 {
+			m.Put(i, i*i)
+		}
+
+		if !m.MoveToEnd(0) || !m.MoveToFront(3) || m.MoveToEnd(99) || m.MoveToFront(99) {
+			t.Error("Moves should report presence correctly")
+		}
+		if !slices.Equal(m.Keys(), []int{3, 1, 2, 0}) {
+			t.Errorf("Expected [3 1 2 0], got %v", m.Keys())
+		}
+
+		if k, v, ok := m.First(); !ok || k != 3 || v != 9 {
+			t.Errorf("First should be 3:9, got %d:%d", k, v)
+		}
+		if k, _, ok := m.Last(); !ok || k != 0 {
+			t.Errorf("Last should be 0, got %d", k)
+		}
+
+		m.Clear()
+		if _, _, ok := m.First(); ok || !m.IsEmpty() || m.String() != "[]" {
+			t.Error("Map should be empty after Clear")
+		}
+		if _, _, ok := m.Last(); ok {
+			t.Error("Last on empty map should report false")
+		}
+	})
+
+	t.Run("Iteration", func(t *testing.T) {
+		m := ordered.NewOrderedMap[string, int]()
+		for i, k := range []string{"c", "a", "b"} {
+			m.Put(k, i)
+		}
+
+		var forward, backward []string
+		for k := range m.All() {
+			forward = append(forward, k)
+		}
+		for k := range m.Backward() {
+			backward = append(backward, k)
+		}
+		if !slices.Equal(forward, []string{"c", "a", "b"}) || !slices.Equal(backward, []string{"b", "a", "c"}) {
+			t.Errorf("Unexpected iteration order %v / %v", forward, backward)
+		}
+
+		// Deleting the current key while iterating is allowed
+		for k := range m.All() {
+			if k != "a" {
+				m.Delete(k)
+			}
+		}
+		if !slices.Equal(m.Keys(), []string{"a"}) {
+			t.Errorf("Expected [a] after deleting during iteration, got %v", m.Keys())
+		}
+	})
+}
+
+func TestOrderedSet(t *testing.T) {
+	s := ordered.FromOrderedSetSlice([]string{"b", "a", "b", "c"})
+
+	if s.Size() != 3 || !slices.Equal(s.ToSlice(), []string{"b", "a", "c"}) {
+		t.Errorf("Expected [b a c], got %v", s.ToSlice())
+	}
+	if s.Add("a") || !s.Add("d") {
+		t.Error("Add should report whether the element was new")
+	}
+	if !s.Remove("b") || s.Remove("b") || s.Contains("b") {
+		t.Error("Remove should report presence correctly")
+	}
+
+	s.MoveToEnd("a")
+	s.MoveToFront("d")
+	if s.String() != "[d c a]" {
+		t.Errorf("Expected [d c a], got %s", s.String())
+	}
+	if first, _ := s.First(); first != "d" {
+		t.Errorf("First should be d, got %s", first)
+	}
+	if last, _ := s.Last(); last != "a" {
+		t.Errorf("Last should be a, got %s", last)
+	}
+	if got := slices.Collect(s.Backward()); !slices.Equal(got, []string{"a", "c", "d"}) {
+		t.Errorf("Backward should be [a c d], got %v", got)
+	}
+	if got := slices.Collect(s.All()); !slices.Equal(got, []string{"d", "c", "a"}) {
+		t.Errorf("All should be [d c a], got %v", got)
+	}
+
+	s.Clear()
+	if !s.IsEmpty() || s.String() != "[]" {
+		t.Error("Set should be empty after Clear")
+	}
+	if _, ok := s.First(); ok {
+		t.Error("First on empty set should report false")
+	}
+}
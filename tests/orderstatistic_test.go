@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/tree"
+)
+
+func TestOrderStatisticTree(t *testing.T) {
+	t.Run("Rank and Select with Duplicates", func(t *testing.T) {
+		ost := tree.FromOrderStatisticTreeSlice([]int{50, 20, 20, 70, 10, 20, 40})
+
+		if ost.Size() != 7 {
+			t.Errorf("Size should count duplicates, expected 7, got %d", ost.Size())
+		}
+		if ost.Count(20) != 3 {
+			t.Errorf("Count(20) should be 3, got %d", ost.Count(20))
+		}
+
+		expected := []int{10, 20, 20, 20, 40, 50, 70}
+		for k, exp := range expected {
+			val, err := ost.Select(k)
+			if err != nil || val != exp {
+				t.Errorf("Select(%d): expected %d, got %d", k, exp, val)
+			}
+		}
+
+		rankCases := map[int]int{5: 0, 10: 0, 15: 1, 20: 1, 30: 4, 70: 6, 99: 7}
+		for item, want := range rankCases {
+			if got := ost.Rank(item); got != want {
+				t.Errorf("Rank(%d): expected %d, got %d", item, want, got)
+			}
+		}
+
+		if _, err := ost.Select(7); err == nil {
+			t.Error("Select past the end should return error")
+		}
+		if _, err := ost.Select(-1); err == nil {
+			t.Error("Select with negative index should return error")
+		}
+	})
+
+	t.Run("CountRange", func(t *testing.T) {
+		ost := tree.FromOrderStatisticTreeSlice([]int{1, 3, 3, 5, 7, 9})
+
+		cases := []struct{ lo, hi, want int }{
+			{3, 7, 4},
+			{0, 100, 6},
+			{4, 4, 0},
+			{3, 3, 2},
+			{8, 2, 0},
+		}
+		for _, c := range cases {
+			if got := ost.CountRange(c.lo, c.hi); got != c.want {
+				t.Errorf("CountRange(%d, %d): expected %d, got %d", c.lo, c.hi, c.want, got)
+			}
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		ost := tree.FromOrderStatisticTreeSlice([]int{4, 2, 2, 6})
+
+		if !ost.Delete(2) || ost.Count(2) != 1 {
+			t.Error("Delete should remove a single occurrence")
+		}
+		if ost.Delete(3) {
+			t.Error("Delete should return false for missing items")
+		}
+		if !slices.Equal(ost.ToSlice(), []int{2, 4, 6}) {
+			t.Errorf("Expected [2 4 6], got %v", ost.ToSlice())
+		}
+		if ost.String() != "[2 4 6]" {
+			t.Errorf("Expected [2 4 6], got %s", ost.String())
+		}
+
+		if min, _ := ost.Min(); min != 2 {
+			t.Errorf("Min should be 2, got %d", min)
+		}
+		if max, _ := ost.Max(); max != 6 {
+			t.Errorf("Max should be 6, got %d", max)
+		}
+
+		ost.Clear()
+		if !ost.IsEmpty() || ost.Size() != 0 {
+			t.Error("Tree should be empty after Clear")
+		}
+		if _, err := ost.Min(); err == nil {
+			t.Error("Min on empty tree should return error")
+		}
+	})
+
+	t.Run("Random Operations Match Sorted Slice", func(t *testing.T) {
+		ost := tree.NewOrderStatisticTree[int]()
+		var reference []int
+		rng := rand.New(rand.NewSource(7))
+
+		for range 3000 {
+			v := rng.Intn(200)
+			if rng.Intn(3) == 0 {
+				idx, found := slices.BinarySearch(reference, v)
+				if ost.Delete(v) != found {
+					t.Fatalf("Delete(%d) disagreed with reference", v)
+				}
+				if found {
+					reference = slices.Delete(reference, idx, idx+1)
+				}
+			} else {
+				ost.Insert(v)
+				idx, _ := slices.BinarySearch(reference, v)
+				reference = slices.Insert(reference, idx, v)
+			}
+
+			probe := rng.Intn(200)
+			want, _ := slices.BinarySearch(reference, probe)
+			if got := ost.Rank(probe); got != want {
+				t.Fatalf("Rank(%d): expected %d, got %d", probe, want, got)
+			}
+		}
+
+		if !slices.Equal(ost.ToSlice(), reference) {
+			t.Fatal("Tree contents disagree with reference")
+		}
+		for k, want := range reference {
+			if got, _ := ost.Select(k); got != want {
+				t.Fatalf("Select(%d): expected %d, got %d", k, want, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/abhishekR-tech/collections/linear"
+)
+
+type task struct {
+	name     string
+	priority int
+}
+
+func TestPriorityQueue(t *testing.T) {
+	t.Run("Custom Ordering", func(t *testing.T) {
+		pq := linear.NewPriorityQueue(func(a, b task) bool {
+			return a.priority > b.priority
+		})
+
+		// Test initial state
+		if !pq.IsEmpty() {
+			t.Error("New priority queue should be empty")
+		}
+
+		pq.Push(task{"write", 2})
+		pq.Push(task{"deploy", 5})
+		pq.Push(task{"review", 3})
+		pq.Push(task{"lunch", 1})
+
+		if pq.Size() != 4 {
+			t.Errorf("Priority queue size should be 4, got %d", pq.Size())
+		}
+
+		val, err := pq.Peek()
+		if err != nil || val.name != "deploy" {
+			t.Errorf("Peek should return 'deploy', got '%s'", val.name)
+		}
+
+		expected := []string{"deploy", "review", "write", "lunch"}
+		for i, exp := range expected {
+			val, err := pq.Pop()
+			if err != nil {
+				t.Errorf("Pop %d should not return error", i)
+			}
+			if val.name != exp {
+				t.Errorf("Pop %d: expected %s, got %s", i, exp, val.name)
+			}
+		}
+
+		// Test Pop and Peek on empty priority queue
+		if _, err := pq.Pop(); err == nil {
+			t.Error("Pop should return error for empty priority queue")
+		}
+		if _, err := pq.Peek(); err == nil {
+			t.Error("Peek should return error for empty priority queue")
+		}
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		pq := linear.NewPriorityQueue(func(a, b int) bool { return a < b })
+
+		if _, err := pq.Replace(1); err == nil {
+			t.Error("Replace should return error for empty priority queue")
+		}
+
+		pq.Push(3)
+		pq.Push(1)
+		pq.Push(2)
+
+		val, err := pq.Replace(5)
+		if err != nil || val != 1 {
+			t.Errorf("Replace should return 1, got %d", val)
+		}
+		if pq.Size() != 3 {
+			t.Errorf("Replace should keep size 3, got %d", pq.Size())
+		}
+
+		expected := []int{2, 3, 5}
+		for i, exp := range expected {
+			if val, _ := pq.Pop(); val != exp {
+				t.Errorf("Pop %d: expected %d, got %d", i, exp, val)
+			}
+		}
+	})
+
+	t.Run("FromPriorityQueueSlice", func(t *testing.T) {
+		input := []int{9, 4, 7, 1, 8, 2}
+		pq := linear.FromPriorityQueueSlice(input, func(a, b int) bool { return a < b })
+
+		input[0] = -1
+		expected := []int{1, 2, 4, 7, 8, 9}
+		for i, exp := range expected {
+			if val, _ := pq.Pop(); val != exp {
+				t.Errorf("Pop %d: expected %d, got %d", i, exp, val)
+			}
+		}
+	})
+
+	t.Run("Clear and String", func(t *testing.T) {
+		pq := linear.NewPriorityQueue(func(a, b int) bool { return a < b })
+		if pq.String() != "[]" {
+			t.Errorf("Empty priority queue should print [], got %s", pq.String())
+		}
+
+		pq.Push(2)
+		pq.Push(1)
+		if pq.String() != "[1 2]" {
+			t.Errorf("Expected [1 2], got %s", pq.String())
+		}
+
+		pq.Clear()
+		if !pq.IsEmpty() {
+			t.Error("Priority queue should be empty after Clear")
+		}
+	})
+}
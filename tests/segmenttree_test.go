@@ -0,0 +1,191 @@
+package tests
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/rangequery"
+)
+
+func TestSegmentTree(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+
+	t.Run("Range Sum", func(t *testing.T) {
+		st := rangequery.FromSegmentTreeSlice([]int{5, 3, 7, 9, 6, 4, 1, 2}, add, 0)
+
+		if sum, err := st.Query(2, 6); err != nil || sum != 26 {
+			t.Errorf("Expected sum 26, got %d", sum)
+		}
+		if sum, _ := st.Query(3, 3); sum != 0 {
+			t.Errorf("Empty range should yield the identity, got %d", sum)
+		}
+		if st.QueryAll() != 37 {
+			t.Errorf("Expected total 37, got %d", st.QueryAll())
+		}
+
+		st.Set(4, 10)
+		if v, _ := st.Get(4); v != 10 {
+			t.Errorf("Expected 10 at index 4, got %d", v)
+		}
+		if sum, _ := st.Query(2, 6); sum != 30 {
+			t.Errorf("Expected sum 30 after Set, got %d", sum)
+		}
+		if st.Size() != 8 || st.String() != "[5 3 7 9 10 4 1 2]" {
+			t.Errorf("Unexpected contents %s", st.String())
+		}
+	})
+
+	t.Run("Range Min", func(t *testing.T) {
+		st := rangequery.NewSegmentTree(5, func(a, b int) int { return min(a, b) }, math.MaxInt)
+		for i, v := range []int{4, 2, 6, 1, 5} {
+			st.Set(i, v)
+		}
+		if m, _ := st.Query(0, 3); m != 2 {
+			t.Errorf("Expected min 2, got %d", m)
+		}
+		if m, _ := st.Query(2, 5); m != 1 {
+			t.Errorf("Expected min 1, got %d", m)
+		}
+	})
+
+	t.Run("Non Commutative", func(t *testing.T) {
+		concat := func(a, b string) string { return a + b }
+		st := rangequery.FromSegmentTreeSlice([]string{"a", "b", "c", "d", "e"}, concat, "")
+		if s, _ := st.Query(1, 4); s != "bcd" {
+			t.Errorf("Expected bcd, got %s", s)
+		}
+	})
+
+	t.Run("Binary Search", func(t *testing.T) {
+		st := rangequery.FromSegmentTreeSlice([]int{2, 1, 3, 2, 4, 1}, add, 0)
+		atMost := func(limit int) func(int) bool {
+			return func(sum int) bool { return sum <= limit }
+		}
+
+		if r := st.MaxRight(0, atMost(6)); r != 3 {
+			t.Errorf("Expected MaxRight 3, got %d", r)
+		}
+		if r := st.MaxRight(2, atMost(100)); r != 6 {
+			t.Errorf("Expected MaxRight 6, got %d", r)
+		}
+		if r := st.MaxRight(4, atMost(3)); r != 4 {
+			t.Errorf("Expected MaxRight 4, got %d", r)
+		}
+		if l := st.MinLeft(6, atMost(5)); l != 4 {
+			t.Errorf("Expected MinLeft 4, got %d", l)
+		}
+		if l := st.MinLeft(3, atMost(100)); l != 0 {
+			t.Errorf("Expected MinLeft 0, got %d", l)
+		}
+		if st.MaxRight(7, atMost(1)) != -1 || st.MinLeft(-1, atMost(1)) != -1 {
+			t.Error("Out of bounds positions should return -1")
+		}
+	})
+
+	t.Run("Bounds", func(t *testing.T) {
+		st := rangequery.NewSegmentTree(3, add, 0)
+		if err := st.Set(3, 1); err == nil {
+			t.Error("Set out of bounds should fail")
+		}
+		if _, err := st.Get(-1); err == nil {
+			t.Error("Get out of bounds should fail")
+		}
+		if _, err := st.Query(2, 1); err == nil {
+			t.Error("Inverted range should fail")
+		}
+
+		empty := rangequery.NewSegmentTree(0, add, 0)
+		if empty.QueryAll() != 0 || empty.String() != "[]" || empty.MaxRight(0, func(int) bool { return false }) != 0 {
+			t.Error("Empty tree should behave as an empty array")
+		}
+	})
+}
+
+func TestLazySegmentTree(t *testing.T) {
+	// Range affine updates x -> mul*x + add over range sums
+	type affine struct{ mul, add int }
+	ops := rangequery.LazyOps[int, affine]{
+		Combine:  func(a, b int) int { return a + b },
+		Identity: 0,
+		Apply: func(f affine, x int, length int) int {
+			return f.mul*x + f.add*length
+		},
+		Compose: func(f, g affine) affine {
+			return affine{f.mul * g.mul, f.mul*g.add + f.add}
+		},
+		NoUpdate: affine{1, 0},
+	}
+
+	t.Run("Range Updates", func(t *testing.T) {
+		st := rangequery.FromLazySegmentTreeSlice([]int{1, 2, 3, 4, 5}, ops)
+
+		st.Apply(1, 4, affine{1, 10})
+		if sum, _ := st.Query(0, 5); sum != 45 {
+			t.Errorf("Expected sum 45 after range add, got %d", sum)
+		}
+		st.Apply(0, 3, affine{2, 0})
+		if !slices.Equal(st.ToSlice(), []int{2, 24, 26, 14, 5}) {
+			t.Errorf("Unexpected contents %v", st.ToSlice())
+		}
+
+		// Assignment is the affine map x -> 0*x + v
+		st.Apply(2, 5, affine{0, 7})
+		if sum, _ := st.Query(1, 4); sum != 38 {
+			t.Errorf("Expected sum 38 after assignment, got %d", sum)
+		}
+		if v, _ := st.Get(4); v != 7 {
+			t.Errorf("Expected 7 at index 4, got %d", v)
+		}
+
+		st.Set(3, 0)
+		if st.QueryAll() != 40 || st.String() != "[2 24 7 0 7]" {
+			t.Errorf("Unexpected contents %s", st.String())
+		}
+	})
+
+	t.Run("Range Min With Add", func(t *testing.T) {
+		minOps := rangequery.LazyOps[int, int]{
+			Combine:  func(a, b int) int { return min(a, b) },
+			Identity: math.MaxInt,
+			Apply: func(f int, x int, _ int) int {
+				if x == math.MaxInt {
+					return x
+				}
+				return x + f
+			},
+			Compose:  func(f, g int) int { return f + g },
+			NoUpdate: 0,
+		}
+		st := rangequery.NewLazySegmentTree(6, minOps)
+		for i := range 6 {
+			st.Set(i, 0)
+		}
+		st.Apply(0, 4, 3)
+		st.Apply(0, 2, -1)
+
+		if m, _ := st.Query(0, 3); m != 2 {
+			t.Errorf("Expected min 2, got %d", m)
+		}
+		if m, _ := st.Query(0, 6); m != 0 {
+			t.Errorf("Expected min 0, got %d", m)
+		}
+		// First index whose prefix minimum drops below 1
+		if r := st.MaxRight(0, func(m int) bool { return m >= 1 }); r != 4 {
+			t.Errorf("Expected MaxRight 4, got %d", r)
+		}
+		if l := st.MinLeft(4, func(m int) bool { return m >= 3 }); l != 2 {
+			t.Errorf("Expected MinLeft 2, got %d", l)
+		}
+	})
+
+	t.Run("Bounds", func(t *testing.T) {
+		st := rangequery.NewLazySegmentTree(2, ops)
+		if err := st.Apply(0, 3, affine{1, 1}); err == nil {
+			t.Error("Apply out of bounds should fail")
+		}
+		if _, err := st.Query(-1, 1); err == nil {
+			t.Error("Query out of bounds should fail")
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/tree"
+)
+
+func TestSequence(t *testing.T) {
+	t.Run("Editing", func(t *testing.T) {
+		s := tree.FromSequenceSlice([]rune("hello world"))
+
+		s.Insert(5, ',')
+		s.Insert(0, '>')
+		if string(s.ToSlice()) != ">hello, world" {
+			t.Errorf("Unexpected text %q", string(s.ToSlice()))
+		}
+
+		if r, err := s.Delete(0); err != nil || r != '>' {
+			t.Errorf("Expected to delete '>', got %q", r)
+		}
+		s.DeleteRange(5, 7)
+		s.Set(0, 'H')
+		if string(s.ToSlice()) != "Helloworld" || s.Size() != 10 {
+			t.Errorf("Unexpected text %q", string(s.ToSlice()))
+		}
+		if r, _ := s.At(5); r != 'w' {
+			t.Errorf("Expected 'w' at index 5, got %q", r)
+		}
+
+		if err := s.Insert(11, '!'); err == nil {
+			t.Error("Insert past the end should fail")
+		}
+		if _, err := s.Delete(10); err == nil {
+			t.Error("Delete out of bounds should fail")
+		}
+		if err := s.DeleteRange(4, 2); err == nil {
+			t.Error("Inverted range should fail")
+		}
+	})
+
+	t.Run("Reverse", func(t *testing.T) {
+		s := tree.FromSequenceSlice([]int{1, 2, 3, 4, 5, 6, 7, 8})
+		s.Reverse(2, 6)
+		if !slices.Equal(s.ToSlice(), []int{1, 2, 6, 5, 4, 3, 7, 8}) {
+			t.Errorf("Unexpected order %v", s.ToSlice())
+		}
+		s.Reverse(0, 8)
+		s.Reverse(3, 5)
+		if s.String() != "[8 7 3 5 4 6 2 1]" {
+			t.Errorf("Unexpected order %s", s.String())
+		}
+	})
+
+	t.Run("Split and Merge", func(t *testing.T) {
+		s := tree.FromSequenceSlice([]string{"a", "b", "c", "d", "e"})
+		rest, err := s.Split(2)
+		if err != nil || s.String() != "[a b]" || rest.String() != "[c d e]" {
+			t.Errorf("Unexpected split %s | %s", s.String(), rest.String())
+		}
+
+		rest.Merge(s)
+		if rest.String() != "[c d e a b]" || !s.IsEmpty() {
+			t.Errorf("Merge should move every element, got %s", rest.String())
+		}
+		if _, err := rest.Split(6); err == nil {
+			t.Error("Split past the end should fail")
+		}
+
+		rest.Clear()
+		if !rest.IsEmpty() || rest.String() != "[]" {
+			t.Error("Sequence should be empty after Clear")
+		}
+	})
+
+	t.Run("Aggregates", func(t *testing.T) {
+		sum := tree.NewSequenceFunc(func(a, b int) int { return a + b }, 0)
+		for i := 1; i <= 10; i++ {
+			sum.Append(i)
+		}
+		if total, _ := sum.Aggregate(0, 10); total != 55 {
+			t.Errorf("Expected sum 55, got %d", total)
+		}
+		sum.Reverse(0, 5)
+		if part, _ := sum.Aggregate(3, 7); part != 2+1+6+7 {
+			t.Errorf("Expected sum 16 after reversal, got %d", part)
+		}
+
+		// Concatenation is not commutative, so reversal must reverse the aggregate too
+		text := tree.NewSequenceFunc(func(a, b string) string { return a + b }, "")
+		for _, w := range []string{"a", "b", "c", "d", "e"} {
+			text.Append(w)
+		}
+		text.Reverse(1, 4)
+		if agg, _ := text.Aggregate(0, 5); agg != "adcbe" {
+			t.Errorf("Expected adcbe, got %s", agg)
+		}
+		if agg, _ := text.Aggregate(2, 2); agg != "" {
+			t.Errorf("Empty range should yield the identity, got %s", agg)
+		}
+
+		if _, err := tree.NewSequence[int]().Aggregate(0, 0); err == nil {
+			t.Error("Aggregate without a combine function should fail")
+		}
+	})
+
+	t.Run("Large", func(t *testing.T) {
+		s := tree.NewSequence[int]()
+		for i := range 100000 {
+			s.Insert(i/2, i)
+		}
+		if s.Size() != 100000 {
+			t.Errorf("Expected 100000 elements, got %d", s.Size())
+		}
+		first, _ := s.At(0)
+		middle, _ := s.At(49999)
+		last, _ := s.At(99999)
+		if first != 1 || middle != 99999 || last != 0 {
+			t.Errorf("Expected 1, 99999 and 0, got %d, %d and %d", first, middle, last)
+		}
+	})
+}
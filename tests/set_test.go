@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/linear"
+	"github.com/abhishekR-tech/collections/set"
+)
+
+func TestSet(t *testing.T) {
+	t.Run("Basic Operations", func(t *testing.T) {
+		s := set.NewSet[string]()
+
+		if !s.IsEmpty() {
+			t.Error("New set should be empty")
+		}
+		if !s.Add("a") || s.Add("a") {
+			t.Error("Add should report whether the element was new")
+		}
+		s.Add("b")
+
+		if s.Size() != 2 || !s.Contains("b") || s.Contains("c") {
+			t.Error("Contains and Size disagree with Add")
+		}
+		if !s.Remove("a") || s.Remove("a") {
+			t.Error("Remove should report presence correctly")
+		}
+
+		s.Clear()
+		if !s.IsEmpty() {
+			t.Error("Set should be empty after Clear")
+		}
+	})
+
+	t.Run("Algebra", func(t *testing.T) {
+		a := set.FromSetSlice([]int{1, 2, 3, 4})
+		b := set.FromSetSlice([]int{3, 4, 5})
+
+		check := func(name string, got *set.Set[int], want []int) {
+			items := got.ToSlice()
+			slices.Sort(items)
+			if !slices.Equal(items, want) {
+				t.Errorf("%s: expected %v, got %v", name, want, items)
+			}
+		}
+		check("Union", a.Union(b), []int{1, 2, 3, 4, 5})
+		check("Intersection", a.Intersection(b), []int{3, 4})
+		check("Difference", a.Difference(b), []int{1, 2})
+		check("SymmetricDifference", a.SymmetricDifference(b), []int{1, 2, 5})
+
+		// Operands are left untouched
+		if a.Size() != 4 || b.Size() != 3 {
+			t.Error("Set algebra should not modify its operands")
+		}
+	})
+
+	t.Run("Comparisons", func(t *testing.T) {
+		small := set.FromSetSlice([]int{1, 2})
+		large := set.FromSetSlice([]int{1, 2, 3})
+		other := set.FromSetSlice([]int{2, 1})
+
+		if !small.IsSubset(large) || large.IsSubset(small) {
+			t.Error("IsSubset gave wrong answers")
+		}
+		if !large.IsSuperset(small) || small.IsSuperset(large) {
+			t.Error("IsSuperset gave wrong answers")
+		}
+		if !small.Equal(other) || small.Equal(large) {
+			t.Error("Equal gave wrong answers")
+		}
+		if !set.NewSet[int]().IsSubset(small) {
+			t.Error("Empty set should be a subset of every set")
+		}
+	})
+
+	t.Run("Iteration and Clone", func(t *testing.T) {
+		s := set.FromSetSlice([]int{3, 1, 2})
+
+		items := slices.Collect(s.All())
+		slices.Sort(items)
+		if !slices.Equal(items, []int{1, 2, 3}) {
+			t.Errorf("All should visit every element, got %v", items)
+		}
+
+		clone := s.Clone()
+		clone.Add(4)
+		if s.Contains(4) {
+			t.Error("Clone should be independent of the original")
+		}
+		if s.String() != "[1 2 3]" {
+			t.Errorf("Expected [1 2 3], got %s", s.String())
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		s := set.FromSetSlice([]string{"b", "a", "c"})
+
+		data, err := json.Marshal(s)
+		if err != nil {
+			t.Fatalf("Marshal should succeed, got %v", err)
+		}
+		if string(data) != `["a","b","c"]` {
+			t.Errorf(`Expected ["a","b","c"], got %s`, data)
+		}
+
+		var decoded set.Set[string]
+		if err := json.Unmarshal([]byte(`["x","y","x"]`), &decoded); err != nil {
+			t.Fatalf("Unmarshal should succeed, got %v", err)
+		}
+		if decoded.Size() != 2 || !decoded.Contains("x") || !decoded.Contains("y") {
+			t.Errorf("Expected {x y}, got %s", decoded.String())
+		}
+
+		if err := json.Unmarshal([]byte(`{"x":1}`), &decoded); err == nil {
+			t.Error("Unmarshal of a non-array should return error")
+		}
+	})
+
+	t.Run("Implements Collection", func(t *testing.T) {
+		var c linear.Collection[int] = set.FromSetSlice([]int{1})
+		if c.Size() != 1 || c.IsEmpty() || len(c.ToSlice()) != 1 || c.String() != "[1]" {
+			t.Error("Set should behave as a linear.Collection")
+		}
+		c.Clear()
+		if !c.IsEmpty() {
+			t.Error("Collection should be empty after Clear")
+		}
+	})
+}
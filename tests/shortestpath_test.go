@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/graph"
+)
+
+// roadNetwork builds a small directed graph with a tempting but longer direct route
+func roadNetwork() *graph.Graph[string, int] {
+	g := graph.NewDirectedGraph[string, int]()
+	g.AddEdge("a", "b", 7)
+	g.AddEdge("a", "c", 9)
+	g.AddEdge("a", "f", 14)
+	g.AddEdge("b", "c", 10)
+	g.AddEdge("b", "d", 15)
+	g.AddEdge("c", "d", 11)
+	g.AddEdge("c", "f", 2)
+	g.AddEdge("d", "e", 6)
+	g.AddEdge("f", "e", 9)
+	g.AddVertex("z")
+	return g
+}
+
+func TestDijkstra(t *testing.T) {
+	t.Run("Road Network", func(t *testing.T) {
+		paths, err := graph.Dijkstra(roadNetwork(), "a")
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		expected := map[string]int{"a": 0, "b": 7, "c": 9, "d": 20, "e": 20, "f": 11}
+		for v, d := range expected {
+			if got, ok := paths.DistanceTo(v); !ok || got != d {
+				t.Errorf("Expected distance %d to %s, got %d", d, v, got)
+			}
+		}
+		if path, ok := paths.PathTo("e"); !ok || !slices.Equal(path, []string{"a", "c", "f", "e"}) {
+			t.Errorf("Expected [a c f e], got %v", path)
+		}
+		if path, _ := paths.PathTo("a"); !slices.Equal(path, []string{"a"}) {
+			t.Errorf("Path to the source should be [a], got %v", path)
+		}
+		if _, ok := paths.PathTo("z"); ok {
+			t.Error("Unreachable vertex should have no path")
+		}
+	})
+
+	t.Run("Float Weights", func(t *testing.T) {
+		g := graph.NewUndirectedGraph[int, float64]()
+		g.AddEdge(1, 2, 0.5)
+		g.AddEdge(2, 3, 0.25)
+		g.AddEdge(1, 3, 1)
+
+		paths, _ := graph.Dijkstra(g, 3)
+		if d, _ := paths.DistanceTo(1); d != 0.75 {
+			t.Errorf("Expected distance 0.75, got %v", d)
+		}
+	})
+
+	t.Run("Negative Weight", func(t *testing.T) {
+		g := graph.NewDirectedGraph[int, int]()
+		g.AddEdge(1, 2, -1)
+		if _, err := graph.Dijkstra(g, 1); err == nil {
+			t.Error("Dijkstra should reject negative weights")
+		}
+	})
+}
+
+func TestAStar(t *testing.T) {
+	type cell struct{ r, c int }
+
+	// A 10x10 grid with a wall in column 5 that leaves a gap in the last row
+	g := graph.NewUndirectedGraph[cell, int]()
+	for r := range 10 {
+		for c := range 10 {
+			if c == 5 && r < 9 {
+				continue
+			}
+			if r+1 < 10 && !(c == 5 && r+1 < 9) {
+				g.AddEdge(cell{r, c}, cell{r + 1, c}, 1)
+			}
+			if c+1 < 10 && !(c+1 == 5 && r < 9) {
+				g.AddEdge(cell{r, c}, cell{r, c + 1}, 1)
+			}
+		}
+	}
+
+	target := cell{0, 9}
+	manhattan := func(v cell) int {
+		return max(v.r-target.r, target.r-v.r) + max(v.c-target.c, target.c-v.c)
+	}
+
+	paths, err := graph.AStar(g, cell{0, 0}, target, manhattan)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if d, ok := paths.DistanceTo(target); !ok || d != 27 {
+		t.Errorf("Expected distance 27 around the wall, got %d", d)
+	}
+	path, _ := paths.PathTo(target)
+	if len(path) != 28 || path[0] != (cell{0, 0}) || path[27] != target {
+		t.Errorf("Unexpected path %v", path)
+	}
+
+	full, _ := graph.Dijkstra(g, cell{0, 0})
+	if d, _ := full.DistanceTo(target); d != 27 {
+		t.Errorf("Dijkstra disagrees with A*, got %d", d)
+	}
+	if len(paths.Dist) >= len(full.Dist) {
+		t.Errorf("A* should explore fewer vertices, explored %d of %d", len(paths.Dist), len(full.Dist))
+	}
+}
+
+func TestBellmanFord(t *testing.T) {
+	t.Run("Negative Weights", func(t *testing.T) {
+		g := graph.NewDirectedGraph[string, int]()
+		g.AddEdge("s", "a", 4)
+		g.AddEdge("s", "b", 5)
+		g.AddEdge("a", "c", 3)
+		g.AddEdge("b", "a", -3)
+		g.AddEdge("c", "d", 2)
+
+		paths, err := graph.BellmanFord(g, "s")
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if d, _ := paths.DistanceTo("d"); d != 7 {
+			t.Errorf("Expected distance 7, got %d", d)
+		}
+		if path, _ := paths.PathTo("d"); !slices.Equal(path, []string{"s", "b", "a", "c", "d"}) {
+			t.Errorf("Expected [s b a c d], got %v", path)
+		}
+	})
+
+	t.Run("Agrees With Dijkstra", func(t *testing.T) {
+		g := roadNetwork()
+		bf, _ := graph.BellmanFord(g, "a")
+		dj, _ := graph.Dijkstra(g, "a")
+		for v, d := range dj.Dist {
+			if bf.Dist[v] != d {
+				t.Errorf("Distances to %s differ: %d vs %d", v, bf.Dist[v], d)
+			}
+		}
+	})
+
+	t.Run("Negative Cycle", func(t *testing.T) {
+		g := graph.NewDirectedGraph[int, int]()
+		g.AddEdge(0, 1, 1)
+		g.AddEdge(1, 2, -2)
+		g.AddEdge(2, 1, 1)
+		g.AddEdge(3, 3, -1)
+
+		if _, err := graph.BellmanFord(g, 0); err == nil {
+			t.Error("Reachable negative cycle should be reported")
+		}
+		if _, err := graph.BellmanFord(g, 1); err == nil {
+			t.Error("Negative cycle through the source should be reported")
+		}
+		g.RemoveEdge(2, 1)
+		if _, err := graph.BellmanFord(g, 0); err != nil {
+			t.Errorf("Unreachable negative cycle should be ignored, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,475 @@
+package tests
+
+import (
+	"hash/maphash"
+	"math"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/probabilistic"
+)
+
+func TestCountMinSketch(t *testing.T) {
+	t.Run("Error Bound", func(t *testing.T) {
+		s := probabilistic.NewCountMinSketchFunc(0.001, 0.01, func(i int) uint64 { return uint64(i) })
+		if s.Width() != 2719 || s.Depth() != 5 {
+			t.Errorf("Expected a 5x2719 sketch, got %dx%d", s.Depth(), s.Width())
+		}
+
+		exact := make(map[int]uint64)
+		for i := range 100000 {
+			item := i % 5000
+			if i%10 == 0 {
+				item = 7
+			}
+			s.Add(item, 1)
+			exact[item]++
+		}
+		if s.Total() != 100000 {
+			t.Errorf("Expected total 100000, got %d", s.Total())
+		}
+
+		bound := uint64(0.001 * 100000)
+		overshoots := 0
+		for item, count := range exact {
+			estimate := s.Count(item)
+			if estimate < count {
+				t.Fatalf("Count-min sketch must not underestimate: %d has %d, estimated %d", item, count, estimate)
+			}
+			if estimate > count+bound {
+				overshoots++
+			}
+		}
+		if overshoots > len(exact)/50 {
+			t.Errorf("Too many estimates above the error bound: %d of %d", overshoots, len(exact))
+		}
+		if s.Count(7) < 10000 {
+			t.Errorf("Expected at least 10000 for the frequent item, got %d", s.Count(7))
+		}
+
+		s.Clear()
+		if s.Count(7) != 0 || s.Total() != 0 {
+			t.Error("Sketch should be empty after Clear")
+		}
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		seed := maphash.MakeSeed()
+		hash := func(s string) uint64 { return maphash.String(seed, s) }
+		a := probabilistic.NewCountMinSketchFunc(0.01, 0.01, hash)
+		b := probabilistic.NewCountMinSketchFunc(0.01, 0.01, hash)
+		a.Add("apple", 3)
+		b.Add("apple", 2)
+		b.Add("banana", 5)
+
+		if err := a.Merge(b); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if a.Count("apple") < 5 || a.Count("banana") < 5 || a.Total() != 10 {
+			t.Errorf("Merged sketch lost counts: apple %d, banana %d, total %d",
+				a.Count("apple"), a.Count("banana"), a.Total())
+		}
+
+		c := probabilistic.NewCountMinSketchFunc(0.1, 0.01, hash)
+		if err := a.Merge(c); err == nil {
+			t.Error("Expected an error merging sketches of different widths")
+		}
+	})
+
+	t.Run("Reproducible", func(t *testing.T) {
+		seed := maphash.MakeSeed()
+		hash := func(i int) uint64 { return maphash.Comparable(seed, i) }
+		a := probabilistic.NewCountMinSketchFunc(0.05, 0.05, hash)
+		b := probabilistic.NewCountMinSketchFunc(0.05, 0.05, hash)
+		for i := range 1000 {
+			a.Add(i%97, 1)
+			b.Add(i%97, 1)
+		}
+		for i := range 200 {
+			if a.Count(i) != b.Count(i) {
+				t.Fatalf("Sketches with the same hash disagree on %d: %d and %d", i, a.Count(i), b.Count(i))
+			}
+		}
+	})
+
+	t.Run("Default Sketches Merge", func(t *testing.T) {
+		a := probabilistic.NewCountMinSketch[string](0.01, 0.01)
+		b := probabilistic.NewCountMinSketch[string](0.01, 0.01)
+		for i := range 1000 {
+			a.Add("a-"+strconv.Itoa(i), 1)
+			b.Add("b-"+strconv.Itoa(i), 2)
+		}
+		if err := a.Merge(b); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for i := range 1000 {
+			if a.Count("a-"+strconv.Itoa(i)) < 1 || a.Count("b-"+strconv.Itoa(i)) < 2 {
+				t.Fatalf("Merged sketch undercounts item %d", i)
+			}
+		}
+
+		b.Seed(5)
+		if err := a.Merge(b); err == nil {
+			t.Error("Expected an error merging sketches with different seeds")
+		}
+		custom := probabilistic.NewCountMinSketchFunc(0.01, 0.01, func(s string) uint64 { return uint64(len(s)) })
+		if err := a.Merge(custom); err == nil {
+			t.Error("Expected an error merging sketches with different hashes")
+		}
+	})
+
+	t.Run("Seed", func(t *testing.T) {
+		s := probabilistic.NewCountMinSketch[int](0.01, 0.01)
+		s.Add(7, 100)
+		s.Seed(42)
+		if s.Total() != 0 || s.Count(7) != 0 {
+			t.Error("Seed should clear the sketch")
+		}
+		for i := range 10000 {
+			s.Add(i%1000, 1)
+		}
+		// Seeded sketches of numbers give the same estimates in every run
+		if s.Count(7) != 30 {
+			t.Errorf("Expected the seeded estimate 30, got %d", s.Count(7))
+		}
+	})
+
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		for _, params := range [][2]float64{{0, 0.1}, {1, 0.1}, {0.1, 0}, {0.1, 1}} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Expected a panic for epsilon %v and delta %v", params[0], params[1])
+					}
+				}()
+				probabilistic.NewCountMinSketch[int](params[0], params[1])
+			}()
+		}
+	})
+}
+
+func TestHeavyHitters(t *testing.T) {
+	t.Run("Finds Frequent Items", func(t *testing.T) {
+		h := probabilistic.NewHeavyHitters[int](3, 0.001, 0.01)
+		// Items 0, 1 and 2 appear 5000, 4000 and 3000 times among 20000 singletons
+		for i := range 20000 {
+			h.Add(1000+i, 1)
+			if i < 5000 {
+				h.Add(0, 1)
+			}
+			if i < 4000 {
+				h.Add(1, 1)
+			}
+			if i < 3000 {
+				h.Add(2, 1)
+			}
+		}
+
+		top := h.Top()
+		if len(top) != 3 {
+			t.Fatalf("Expected 3 heavy hitters, got %v", top)
+		}
+		for i, hitter := range top {
+			if hitter.Value != i {
+				t.Errorf("Expected item %d at position %d, got %v", i, i, top)
+			}
+		}
+		if top[0].Count < 5000 || h.Count(0) != top[0].Count {
+			t.Errorf("Expected an estimate of at least 5000 for item 0, got %d", top[0].Count)
+		}
+		if h.Total() != 32000 || h.K() != 3 {
+			t.Errorf("Expected total 32000 and k 3, got %d and %d", h.Total(), h.K())
+		}
+	})
+
+	t.Run("Weighted Counts", func(t *testing.T) {
+		h := probabilistic.NewHeavyHitters[string](2, 0.01, 0.01)
+		h.Add("a", 10)
+		h.Add("b", 20)
+		h.Add("c", 5)
+		h.Add("c", 30)
+		h.Add("d", 0)
+
+		top := h.Top()
+		if len(top) != 2 || top[0].Value != "c" || top[1].Value != "b" {
+			t.Errorf("Expected [c b], got %v", top)
+		}
+
+		h.Clear()
+		if len(h.Top()) != 0 || h.Total() != 0 {
+			t.Error("Tracker should be empty after Clear")
+		}
+	})
+
+	t.Run("Seed", func(t *testing.T) {
+		a := probabilistic.NewHeavyHitters[int](5, 0.01, 0.01)
+		b := probabilistic.NewHeavyHitters[int](5, 0.01, 0.01)
+		a.Add(1, 10)
+		a.Seed(3)
+		b.Seed(3)
+		if len(a.Top()) != 0 || a.Total() != 0 {
+			t.Error("Seed should clear the tracker")
+		}
+		for i := range 5000 {
+			a.Add(i%300, uint64(i%7))
+			b.Add(i%300, uint64(i%7))
+		}
+		if !slices.Equal(a.Top(), b.Top()) {
+			t.Errorf("Trackers with the same seed differ: %v and %v", a.Top(), b.Top())
+		}
+	})
+
+	t.Run("Invalid K", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected a panic for k = 0")
+			}
+		}()
+		probabilistic.NewHeavyHitters[int](0, 0.01, 0.01)
+	})
+}
+
+func TestHyperLogLog(t *testing.T) {
+	t.Run("Estimate", func(t *testing.T) {
+		for _, n := range []int{10, 1000, 100000} {
+			// The estimator mixes hashes itself, so a deterministic identity hash keeps the test stable
+			h := probabilistic.NewHyperLogLogFunc(14, func(i int) uint64 { return uint64(i) })
+			for i := range n {
+				h.Add(i)
+				h.Add(i)
+			}
+			// Three standard errors at precision 14 is under 2.5%
+			if estimate := float64(h.Count()); math.Abs(estimate-float64(n)) > 0.025*float64(n)+1 {
+				t.Errorf("Expected an estimate near %d, got %v", n, estimate)
+			}
+		}
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		seed := maphash.MakeSeed()
+		hash := func(i int) uint64 { return maphash.Comparable(seed, i) }
+		a := probabilistic.NewHyperLogLogFunc(12, hash)
+		b := probabilistic.NewHyperLogLogFunc(12, hash)
+		union := probabilistic.NewHyperLogLogFunc(12, hash)
+		for i := range 30000 {
+			a.Add(i)
+			union.Add(i)
+		}
+		for i := 20000; i < 50000; i++ {
+			b.Add(i)
+			union.Add(i)
+		}
+
+		if err := a.Merge(b); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if a.Count() != union.Count() {
+			t.Errorf("Merged estimate %d should equal the union's %d", a.Count(), union.Count())
+		}
+		if estimate := float64(a.Count()); math.Abs(estimate-50000) > 2500 {
+			t.Errorf("Expected an estimate near 50000, got %v", estimate)
+		}
+
+		if err := a.Merge(probabilistic.NewHyperLogLogFunc(10, hash)); err == nil {
+			t.Error("Expected an error merging estimators of different precision")
+		}
+	})
+
+	t.Run("Default Estimators Merge", func(t *testing.T) {
+		a := probabilistic.NewHyperLogLog[int](12)
+		b := probabilistic.NewHyperLogLog[int](12)
+		for i := range 30000 {
+			a.Add(i)
+			b.Add(i + 20000)
+		}
+		if err := a.Merge(b); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if estimate := float64(a.Count()); math.Abs(estimate-50000) > 2500 {
+			t.Errorf("Expected an estimate near 50000, got %v", estimate)
+		}
+
+		b.Seed(9)
+		if err := a.Merge(b); err == nil {
+			t.Error("Expected an error merging estimators with different seeds")
+		}
+	})
+
+	t.Run("Seed", func(t *testing.T) {
+		h := probabilistic.NewHyperLogLog[string](10)
+		h.Add("x")
+		h.Seed(42)
+		if h.Count() != 0 {
+			t.Error("Seed should clear the estimator")
+		}
+		for i := range 10000 {
+			h.Add("item-" + strconv.Itoa(i))
+		}
+		// Seeded estimators of strings give the same estimates in every run
+		if h.Count() != 9896 {
+			t.Errorf("Expected the seeded estimate 9896, got %d", h.Count())
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		h := probabilistic.NewHyperLogLog[string](4)
+		if h.Count() != 0 || h.Precision() != 4 {
+			t.Errorf("Expected an empty estimator of precision 4, got %d and %d", h.Count(), h.Precision())
+		}
+		h.Add("a")
+		h.Add("b")
+		if h.Count() == 0 {
+			t.Error("Expected a non-zero estimate")
+		}
+		h.Clear()
+		if h.Count() != 0 {
+			t.Error("Estimator should count zero after Clear")
+		}
+	})
+
+	t.Run("Invalid Precision", func(t *testing.T) {
+		for _, p := range []int{3, 19} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Expected a panic for precision %d", p)
+					}
+				}()
+				probabilistic.NewHyperLogLog[int](p)
+			}()
+		}
+	})
+}
+
+func TestReservoir(t *testing.T) {
+	t.Run("Short Stream", func(t *testing.T) {
+		r := probabilistic.NewReservoir[int](5)
+		for i := range 3 {
+			r.Add(i)
+		}
+		if got := r.Sample(); !slices.Equal(got, []int{0, 1, 2}) {
+			t.Errorf("Expected every item of a short stream, got %v", got)
+		}
+		if r.Size() != 3 || r.Seen() != 3 || r.K() != 5 {
+			t.Errorf("Unexpected size %d, seen %d or k %d", r.Size(), r.Seen(), r.K())
+		}
+
+		r.Clear()
+		if r.Size() != 0 || r.Seen() != 0 {
+			t.Error("Reservoir should be empty after Clear")
+		}
+	})
+
+	t.Run("Reproducible", func(t *testing.T) {
+		a := probabilistic.NewReservoir[int](10)
+		b := probabilistic.NewReservoir[int](10)
+		a.Seed(42)
+		b.Seed(42)
+		for i := range 1000 {
+			a.Add(i)
+			b.Add(i)
+		}
+		if !slices.Equal(a.Sample(), b.Sample()) {
+			t.Errorf("Reservoirs with the same seed differ: %v and %v", a.Sample(), b.Sample())
+		}
+	})
+
+	t.Run("Uniform", func(t *testing.T) {
+		const n, k, trials = 20, 5, 20000
+		hits := make([]int, n)
+		r := probabilistic.NewReservoir[int](k)
+		r.Seed(7)
+		for range trials {
+			r.Clear()
+			for i := range n {
+				r.Add(i)
+			}
+			for _, item := range r.Sample() {
+				hits[item]++
+			}
+		}
+
+		// Each item should be sampled with probability k/n
+		expected := float64(trials) * k / n
+		for i, h := range hits {
+			if math.Abs(float64(h)-expected) > 0.05*expected {
+				t.Errorf("Item %d sampled %d times, expected about %v", i, h, expected)
+			}
+		}
+	})
+
+	t.Run("Invalid Size", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected a panic for k = 0")
+			}
+		}()
+		probabilistic.NewReservoir[int](0)
+	})
+}
+
+func TestWeightedReservoir(t *testing.T) {
+	t.Run("Weights", func(t *testing.T) {
+		const trials = 10000
+		hits := make([]int, 3)
+		r := probabilistic.NewWeightedReservoir[int](1)
+		r.Seed(1)
+		for range trials {
+			r.Clear()
+			r.Add(0, 1)
+			r.Add(1, 2)
+			r.Add(2, 7)
+			hits[r.Sample()[0]]++
+		}
+
+		// With k = 1 each item is chosen in proportion to its weight
+		for i, weight := range []float64{0.1, 0.2, 0.7} {
+			if p := float64(hits[i]) / trials; math.Abs(p-weight) > 0.02 {
+				t.Errorf("Item %d chosen with frequency %.3f, expected about %.1f", i, p, weight)
+			}
+		}
+	})
+
+	t.Run("Sample Without Replacement", func(t *testing.T) {
+		r := probabilistic.NewWeightedReservoir[string](3)
+		r.Seed(3)
+		for _, s := range []string{"a", "b", "c", "d", "e"} {
+			r.Add(s, 1)
+		}
+		sample := r.Sample()
+		slices.Sort(sample)
+		if len(sample) != 3 || len(slices.Compact(sample)) != 3 {
+			t.Errorf("Expected 3 distinct items, got %v", sample)
+		}
+		if r.Size() != 3 || r.Seen() != 5 || r.K() != 3 {
+			t.Errorf("Unexpected size %d, seen %d or k %d", r.Size(), r.Seen(), r.K())
+		}
+	})
+
+	t.Run("Reproducible", func(t *testing.T) {
+		a := probabilistic.NewWeightedReservoir[int](4)
+		b := probabilistic.NewWeightedReservoir[int](4)
+		a.Seed(9)
+		b.Seed(9)
+		for i := range 500 {
+			a.Add(i, float64(i%7+1))
+			b.Add(i, float64(i%7+1))
+		}
+		if !slices.Equal(a.Sample(), b.Sample()) {
+			t.Errorf("Reservoirs with the same seed differ: %v and %v", a.Sample(), b.Sample())
+		}
+	})
+
+	t.Run("Invalid Weight", func(t *testing.T) {
+		r := probabilistic.NewWeightedReservoir[int](2)
+		for _, w := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+			if err := r.Add(1, w); err == nil {
+				t.Errorf("Expected an error for weight %v", w)
+			}
+		}
+		if r.Size() != 0 || r.Seen() != 0 {
+			t.Error("Rejected items should not be counted")
+		}
+	})
+}
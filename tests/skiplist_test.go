@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/linear"
+)
+
+func TestSkipList(t *testing.T) {
+	t.Run("Basic Operations", func(t *testing.T) {
+		sl := linear.NewSkipList[string, int]()
+		sl.Seed(42)
+
+		for i, k := range []string{"delta", "alpha", "echo", "charlie", "bravo"} {
+			if !sl.Put(k, i) {
+				t.Errorf("Put of new key %s should return true", k)
+			}
+		}
+		if sl.Put("alpha", 10) {
+			t.Error("Put of existing key should return false")
+		}
+		if v, ok := sl.Get("alpha"); !ok || v != 10 {
+			t.Errorf("Expected 10 for alpha, got %d", v)
+		}
+		if sl.Contains("foxtrot") || sl.Size() != 5 {
+			t.Error("Unexpected contents")
+		}
+
+		keys := slices.Collect(sl.Keys())
+		if !slices.Equal(keys, []string{"alpha", "bravo", "charlie", "delta", "echo"}) {
+			t.Errorf("Keys should be sorted, got %v", keys)
+		}
+
+		if !sl.Delete("charlie") || sl.Delete("charlie") || sl.Size() != 4 {
+			t.Error("Delete should report presence correctly")
+		}
+		if sl.String() != "[alpha:10 bravo:4 delta:0 echo:2]" {
+			t.Errorf("Unexpected string %s", sl.String())
+		}
+
+		sl.Clear()
+		if !sl.IsEmpty() || sl.String() != "[]" {
+			t.Error("List should be empty after Clear")
+		}
+		if _, _, ok := sl.Min(); ok {
+			t.Error("Min of empty list should fail")
+		}
+	})
+
+	t.Run("Navigation", func(t *testing.T) {
+		sl := linear.NewSkipList[int, string]()
+		sl.Seed(7)
+		for _, k := range []int{10, 20, 30, 40} {
+			sl.Put(k, "")
+		}
+
+		check := func(name string, got int, ok bool, expected int, expectOK bool) {
+			if ok != expectOK || (ok && got != expected) {
+				t.Errorf("%s: expected %d (%v), got %d (%v)", name, expected, expectOK, got, ok)
+			}
+		}
+		k, _, ok := sl.Floor(25)
+		check("Floor(25)", k, ok, 20, true)
+		k, _, ok = sl.Floor(30)
+		check("Floor(30)", k, ok, 30, true)
+		k, _, ok = sl.Floor(5)
+		check("Floor(5)", k, ok, 0, false)
+		k, _, ok = sl.Ceiling(25)
+		check("Ceiling(25)", k, ok, 30, true)
+		k, _, ok = sl.Ceiling(45)
+		check("Ceiling(45)", k, ok, 0, false)
+		k, _, ok = sl.Lower(30)
+		check("Lower(30)", k, ok, 20, true)
+		k, _, ok = sl.Higher(30)
+		check("Higher(30)", k, ok, 40, true)
+		k, _, ok = sl.Min()
+		check("Min", k, ok, 10, true)
+		k, _, ok = sl.Max()
+		check("Max", k, ok, 40, true)
+
+		var inRange []int
+		for k := range sl.Range(15, 40) {
+			inRange = append(inRange, k)
+		}
+		if !slices.Equal(inRange, []int{20, 30, 40}) {
+			t.Errorf("Expected [20 30 40], got %v", inRange)
+		}
+	})
+
+	t.Run("Positional Access", func(t *testing.T) {
+		sl := linear.NewSkipList[int, int]()
+		sl.Seed(1)
+		for i := range 1000 {
+			sl.Put((i*7)%1000, i)
+		}
+		for i := 0; i < 1000; i += 2 {
+			sl.Delete(i)
+		}
+
+		for i := range 500 {
+			k, _, err := sl.At(i)
+			if err != nil || k != 2*i+1 {
+				t.Fatalf("At(%d): expected %d, got %d", i, 2*i+1, k)
+			}
+			if r := sl.Rank(k); r != i {
+				t.Fatalf("Rank(%d): expected %d, got %d", k, i, r)
+			}
+		}
+		if r := sl.Rank(2000); r != 500 {
+			t.Errorf("Rank past the end should be the size, got %d", r)
+		}
+		if _, _, err := sl.At(500); err == nil {
+			t.Error("At out of bounds should fail")
+		}
+	})
+
+	t.Run("Custom Comparator", func(t *testing.T) {
+		sl := linear.NewSkipListFunc[int, int](func(a, b int) int { return b - a })
+		for i := range 100 {
+			sl.Put(i, i)
+		}
+		if k, _, _ := sl.At(0); k != 99 {
+			t.Errorf("Custom comparator should order descending, got first %d", k)
+		}
+	})
+}
@@ -0,0 +1,170 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/abhishekR-tech/collections/graph"
+)
+
+func TestMinimumSpanningTree(t *testing.T) {
+	g := graph.NewUndirectedGraph[string, int]()
+	g.AddEdge("a", "b", 4)
+	g.AddEdge("a", "h", 8)
+	g.AddEdge("b", "c", 8)
+	g.AddEdge("b", "h", 11)
+	g.AddEdge("c", "d", 7)
+	g.AddEdge("c", "f", 4)
+	g.AddEdge("c", "i", 2)
+	g.AddEdge("d", "e", 9)
+	g.AddEdge("d", "f", 14)
+	g.AddEdge("e", "f", 10)
+	g.AddEdge("f", "g", 2)
+	g.AddEdge("g", "h", 1)
+	g.AddEdge("g", "i", 6)
+	g.AddEdge("h", "i", 7)
+
+	for name, mst := range map[string]func(*graph.Graph[string, int]) ([]graph.Edge[string, int], int){
+		"Kruskal": graph.Kruskal[string, int],
+		"Prim":    graph.Prim[string, int],
+	} {
+		t.Run(name, func(t *testing.T) {
+			edges, total := mst(g)
+			if total != 37 || len(edges) != 8 {
+				t.Errorf("Expected 8 edges of weight 37, got %d of weight %d", len(edges), total)
+			}
+			sum := 0
+			for _, e := range edges {
+				if w, ok := g.Weight(e.From, e.To); !ok || w != e.Weight {
+					t.Errorf("Edge %v is not in the graph", e)
+				}
+				sum += e.Weight
+			}
+			if sum != total {
+				t.Errorf("Edge weights sum to %d, not %d", sum, total)
+			}
+		})
+	}
+
+	t.Run("Forest", func(t *testing.T) {
+		h := graph.NewDirectedGraph[int, float64]()
+		h.AddEdge(1, 2, 1.5)
+		h.AddEdge(3, 2, 0.5)
+		h.AddEdge(1, 3, 3)
+		h.AddEdge(4, 5, 2)
+		h.AddVertex(6)
+
+		kEdges, kTotal := graph.Kruskal(h)
+		pEdges, pTotal := graph.Prim(h)
+		if kTotal != 4 || pTotal != 4 || len(kEdges) != 3 || len(pEdges) != 3 {
+			t.Errorf("Expected a 3-edge forest of weight 4, got %v and %v", kEdges, pEdges)
+		}
+	})
+}
+
+func TestMaxFlow(t *testing.T) {
+	t.Run("Dinic", func(t *testing.T) {
+		g := graph.NewDirectedGraph[string, int]()
+		g.AddEdge("s", "a", 16)
+		g.AddEdge("s", "c", 13)
+		g.AddEdge("a", "b", 12)
+		g.AddEdge("c", "a", 4)
+		g.AddEdge("b", "c", 9)
+		g.AddEdge("c", "d", 14)
+		g.AddEdge("d", "b", 7)
+		g.AddEdge("b", "t", 20)
+		g.AddEdge("d", "t", 4)
+
+		flow, err := graph.MaxFlow(g, "s", "t")
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if flow.Value != 23 {
+			t.Errorf("Expected max flow 23, got %d", flow.Value)
+		}
+
+		// Conservation at every inner vertex and capacity limits on every edge
+		balance := make(map[string]int)
+		for _, e := range flow.Edges() {
+			if c, _ := g.Weight(e.From, e.To); e.Weight > c {
+				t.Errorf("Edge %s->%s exceeds its capacity", e.From, e.To)
+			}
+			balance[e.From] -= e.Weight
+			balance[e.To] += e.Weight
+		}
+		for v, b := range balance {
+			if v != "s" && v != "t" && b != 0 {
+				t.Errorf("Flow is not conserved at %s", v)
+			}
+		}
+		if balance["t"] != 23 || flow.EdgeFlow("b", "t")+flow.EdgeFlow("d", "t") != 23 {
+			t.Error("Flow into the sink should equal the flow value")
+		}
+
+		side, cut := flow.MinCut()
+		capacity := 0
+		for _, e := range cut {
+			capacity += e.Weight
+		}
+		if capacity != 23 || len(side) != 4 {
+			t.Errorf("Expected a cut of capacity 23 with 4 source-side vertices, got %d with %v", capacity, side)
+		}
+	})
+
+	t.Run("Undirected and Float", func(t *testing.T) {
+		g := graph.NewUndirectedGraph[int, float64]()
+		g.AddEdge(1, 2, 1.5)
+		g.AddEdge(2, 3, 2)
+		g.AddEdge(1, 3, 1)
+
+		flow, _ := graph.MaxFlow(g, 3, 1)
+		if flow.Value != 2.5 {
+			t.Errorf("Expected max flow 2.5, got %v", flow.Value)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		g := graph.NewDirectedGraph[int, int]()
+		g.AddEdge(1, 2, -1)
+		if _, err := graph.MaxFlow(g, 1, 2); err == nil {
+			t.Error("Negative capacity should be rejected")
+		}
+		if _, err := graph.MaxFlow(g, 1, 1); err == nil {
+			t.Error("Equal source and sink should be rejected")
+		}
+		if _, err := graph.MaxFlow(g, 1, 9); err == nil {
+			t.Error("Missing sink should be rejected")
+		}
+	})
+}
+
+func TestMinCostMaxFlow(t *testing.T) {
+	type cost = graph.CostEdge[int]
+
+	// Two workers assigned to two jobs through a source and a sink
+	g := graph.NewDirectedGraph[string, cost]()
+	g.AddEdge("s", "w1", cost{Capacity: 1})
+	g.AddEdge("s", "w2", cost{Capacity: 1})
+	g.AddEdge("w1", "j1", cost{Capacity: 1, Cost: 4})
+	g.AddEdge("w1", "j2", cost{Capacity: 1, Cost: 2})
+	g.AddEdge("w2", "j1", cost{Capacity: 1, Cost: 3})
+	g.AddEdge("w2", "j2", cost{Capacity: 1, Cost: 7})
+	g.AddEdge("j1", "t", cost{Capacity: 1})
+	g.AddEdge("j2", "t", cost{Capacity: 1})
+
+	flow, err := graph.MinCostMaxFlow(g, "s", "t")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if flow.Value != 2 || flow.Cost != 5 {
+		t.Errorf("Expected flow 2 at cost 5, got %d at cost %d", flow.Value, flow.Cost)
+	}
+	if flow.EdgeFlow("w1", "j2") != 1 || flow.EdgeFlow("w2", "j1") != 1 || flow.EdgeFlow("w1", "j1") != 0 {
+		t.Errorf("Unexpected assignment %v", flow.Edges())
+	}
+
+	g.AddEdge("w1", "j1", cost{Capacity: 1, Cost: -10})
+	flow, _ = graph.MinCostMaxFlow(g, "s", "t")
+	if flow.Cost != -3 {
+		t.Errorf("Negative costs should be preferred, got cost %d", flow.Cost)
+	}
+}
@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/abhishekR-tech/collections/rangequery"
+)
+
+func TestSparseTable(t *testing.T) {
+	items := []int{12, 18, 6, 30, 24, 9, 27, 15}
+
+	t.Run("Min and Max", func(t *testing.T) {
+		minTable := rangequery.FromSparseTableSlice(items, func(a, b int) int { return min(a, b) })
+		maxTable := rangequery.FromSparseTableSlice(items, func(a, b int) int { return max(a, b) })
+
+		if m, err := minTable.Query(3, 8); err != nil || m != 9 {
+			t.Errorf("Expected min 9, got %d", m)
+		}
+		if m, _ := minTable.Query(0, 8); m != 6 {
+			t.Errorf("Expected min 6, got %d", m)
+		}
+		if m, _ := maxTable.Query(4, 7); m != 27 {
+			t.Errorf("Expected max 27, got %d", m)
+		}
+		if m, _ := maxTable.Query(5, 6); m != 9 {
+			t.Errorf("Single-element range should return the element, got %d", m)
+		}
+	})
+
+	t.Run("GCD", func(t *testing.T) {
+		gcd := func(a, b int) int {
+			for b != 0 {
+				a, b = b, a%b
+			}
+			return a
+		}
+		table := rangequery.FromSparseTableSlice(items, gcd)
+		if g, _ := table.Query(0, 4); g != 6 {
+			t.Errorf("Expected gcd 6, got %d", g)
+		}
+		if g, _ := table.Query(5, 7); g != 9 {
+			t.Errorf("Expected gcd 9, got %d", g)
+		}
+	})
+
+	t.Run("Bounds", func(t *testing.T) {
+		table := rangequery.FromSparseTableSlice(items, func(a, b int) int { return min(a, b) })
+		if _, err := table.Query(2, 2); err == nil {
+			t.Error("Empty range should fail")
+		}
+		if _, err := table.Query(0, 9); err == nil {
+			t.Error("Range past the end should fail")
+		}
+		if v, _ := table.Get(3); v != 30 || table.Size() != 8 {
+			t.Errorf("Expected 30 at index 3 of 8, got %d", v)
+		}
+
+		empty := rangequery.FromSparseTableSlice([]int{}, func(a, b int) int { return min(a, b) })
+		if empty.Size() != 0 || empty.String() != "[]" {
+			t.Error("Empty table should have no elements")
+		}
+	})
+}
+
+func TestCartesianTree(t *testing.T) {
+	items := []int{9, 3, 7, 1, 8, 12, 10, 20, 15, 18, 5}
+	ct := rangequery.FromCartesianTreeSlice(items, func(a, b int) bool { return a < b })
+
+	t.Run("Structure", func(t *testing.T) {
+		if ct.Root() != 3 || ct.Parent(3) != -1 {
+			t.Errorf("Expected the minimum at index 3 as root, got %d", ct.Root())
+		}
+		if ct.Left(3) != 1 || ct.Right(3) != 10 {
+			t.Errorf("Unexpected children of the root: %d and %d", ct.Left(3), ct.Right(3))
+		}
+		if ct.Left(1) != 0 || ct.Right(1) != 2 || ct.Parent(2) != 1 {
+			t.Error("Unexpected left subtree")
+		}
+		if ct.Left(10) != 4 || ct.Right(10) != -1 {
+			t.Error("Unexpected right subtree")
+		}
+
+		// In-order traversal gives back the original order
+		var inorder []int
+		var walk func(i int)
+		walk = func(i int) {
+			if i == -1 {
+				return
+			}
+			walk(ct.Left(i))
+			inorder = append(inorder, i)
+			walk(ct.Right(i))
+		}
+		walk(ct.Root())
+		for i, v := range inorder {
+			if v != i {
+				t.Fatalf("In-order traversal %v is not the index order", inorder)
+			}
+		}
+	})
+
+	t.Run("Range Minimum", func(t *testing.T) {
+		if i, err := ct.MinIndex(4, 10); err != nil || i != 4 {
+			t.Errorf("Expected index 4, got %d", i)
+		}
+		if m, _ := ct.Min(5, 10); m != 10 {
+			t.Errorf("Expected min 10, got %d", m)
+		}
+		if m, _ := ct.Min(0, 3); m != 3 {
+			t.Errorf("Expected min 3, got %d", m)
+		}
+		if lca, _ := ct.LCA(2, 8); lca != 3 {
+			t.Errorf("Expected LCA 3, got %d", lca)
+		}
+		if _, err := ct.MinIndex(3, 3); err == nil {
+			t.Error("Empty range should fail")
+		}
+	})
+
+	t.Run("Ties and Sorted Input", func(t *testing.T) {
+		ties := rangequery.FromCartesianTreeSlice([]int{2, 1, 3, 1, 1}, func(a, b int) bool { return a < b })
+		if i, _ := ties.MinIndex(0, 5); i != 1 {
+			t.Errorf("Ties should resolve to the leftmost index, got %d", i)
+		}
+		if i, _ := ties.MinIndex(2, 5); i != 3 {
+			t.Errorf("Expected index 3, got %d", i)
+		}
+
+		sorted := make([]int, 10000)
+		for i := range sorted {
+			sorted[i] = i
+		}
+		chain := rangequery.FromCartesianTreeSlice(sorted, func(a, b int) bool { return a < b })
+		if m, _ := chain.Min(5000, 9000); m != 5000 {
+			t.Errorf("Expected min 5000 on a degenerate tree, got %d", m)
+		}
+	})
+}
@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/linear"
+)
+
+func TestTopK(t *testing.T) {
+	t.Run("Bounded Stream", func(t *testing.T) {
+		top := linear.NewTopK(3, func(a, b int) bool { return a < b })
+
+		if !top.IsEmpty() {
+			t.Error("New TopK should be empty")
+		}
+
+		for _, v := range []int{5, 1, 9, 3, 7, 2, 8} {
+			top.Push(v)
+		}
+
+		if top.Size() != 3 {
+			t.Errorf("TopK should retain 3 items, got %d", top.Size())
+		}
+
+		result := top.ToSlice()
+		if !slices.Equal(result, []int{9, 8, 7}) {
+			t.Errorf("Expected [9 8 7], got %v", result)
+		}
+		if top.String() != "[9 8 7]" {
+			t.Errorf("Expected [9 8 7], got %s", top.String())
+		}
+
+		top.Clear()
+		if !top.IsEmpty() {
+			t.Error("TopK should be empty after Clear")
+		}
+	})
+
+	t.Run("Custom Score", func(t *testing.T) {
+		top := linear.NewTopK(2, func(a, b task) bool { return a.priority < b.priority })
+		top.Push(task{"a", 10})
+		top.Push(task{"b", 30})
+		top.Push(task{"c", 20})
+
+		result := top.ToSlice()
+		if len(result) != 2 || result[0].name != "b" || result[1].name != "c" {
+			t.Errorf("Expected [b c], got %v", result)
+		}
+	})
+
+	t.Run("Fewer Than K", func(t *testing.T) {
+		top := linear.NewTopK(5, func(a, b int) bool { return a < b })
+		top.Push(2)
+		top.Push(1)
+
+		if result := top.ToSlice(); !slices.Equal(result, []int{2, 1}) {
+			t.Errorf("Expected [2 1], got %v", result)
+		}
+	})
+
+	t.Run("Zero K", func(t *testing.T) {
+		top := linear.NewTopK(0, func(a, b int) bool { return a < b })
+		top.Push(1)
+		if !top.IsEmpty() {
+			t.Error("TopK with k=0 should retain nothing")
+		}
+	})
+}
+
+func TestMergeK(t *testing.T) {
+	t.Run("Merge Sorted Sequences", func(t *testing.T) {
+		merged := linear.MergeK(cmp.Compare[int],
+			slices.Values([]int{1, 4, 7}),
+			slices.Values([]int{2, 5, 8}),
+			slices.Values([]int{}),
+			slices.Values([]int{0, 3, 6, 9}),
+		)
+
+		result := slices.Collect(merged)
+		expected := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("No Sequences", func(t *testing.T) {
+		result := slices.Collect(linear.MergeK[int](cmp.Compare[int]))
+		if len(result) != 0 {
+			t.Errorf("Expected empty result, got %v", result)
+		}
+	})
+
+	t.Run("Early Stop Releases Inputs", func(t *testing.T) {
+		stopped := 0
+		counting := func(values ...int) iter.Seq[int] {
+			return func(yield func(int) bool) {
+				defer func() { stopped++ }()
+				for _, v := range values {
+					if !yield(v) {
+						return
+					}
+				}
+			}
+		}
+
+		var result []int
+		for v := range linear.MergeK(cmp.Compare[int], counting(1, 3, 5), counting(2, 4, 6)) {
+			result = append(result, v)
+			if len(result) == 3 {
+				break
+			}
+		}
+
+		if !slices.Equal(result, []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", result)
+		}
+		if stopped != 2 {
+			t.Errorf("Expected both inputs to be stopped, got %d", stopped)
+		}
+	})
+}
+
+func TestNSmallestNLargest(t *testing.T) {
+	items := []int{5, 1, 9, 3, 7, 2, 8}
+
+	t.Run("Slices", func(t *testing.T) {
+		if result := linear.NSmallest(3, items); !slices.Equal(result, []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", result)
+		}
+		if result := linear.NLargest(3, items); !slices.Equal(result, []int{9, 8, 7}) {
+			t.Errorf("Expected [9 8 7], got %v", result)
+		}
+		if result := linear.NSmallest(10, items); len(result) != len(items) {
+			t.Errorf("Expected all %d items, got %d", len(items), len(result))
+		}
+		if result := linear.NLargest(0, items); len(result) != 0 {
+			t.Errorf("Expected no items, got %v", result)
+		}
+	})
+
+	t.Run("Sliceable", func(t *testing.T) {
+		list := linear.FromLinkedListSlice(items)
+		if result := linear.NSmallestOf(2, list); !slices.Equal(result, []int{1, 2}) {
+			t.Errorf("Expected [1 2], got %v", result)
+		}
+
+		stack := linear.FromStackSlice(items)
+		if result := linear.NLargestOf(2, stack); !slices.Equal(result, []int{9, 8}) {
+			t.Errorf("Expected [9 8], got %v", result)
+		}
+	})
+}
@@ -0,0 +1,262 @@
+package tests
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/tree"
+)
+
+func TestTreeMap(t *testing.T) {
+	t.Run("Put, Get and Delete", func(t *testing.T) {
+		m := tree.NewTreeMap[int, string]()
+
+		if !m.IsEmpty() {
+			t.Error("New map should be empty")
+		}
+
+		m.Put(20, "twenty")
+		m.Put(10, "ten")
+		m.Put(30, "thirty")
+		m.Put(10, "TEN")
+
+		if m.Size() != 3 {
+			t.Errorf("Map size should be 3, got %d", m.Size())
+		}
+		if val, ok := m.Get(10); !ok || val != "TEN" {
+			t.Errorf("Get(10) should return 'TEN', got '%s'", val)
+		}
+		if _, ok := m.Get(15); ok {
+			t.Error("Get should report missing keys")
+		}
+
+		if !m.Delete(20) || m.Delete(20) {
+			t.Error("Delete should report presence correctly")
+		}
+		if m.Contains(20) || m.Size() != 2 {
+			t.Error("Deleted key should be gone")
+		}
+		if m.String() != "[10:TEN 30:thirty]" {
+			t.Errorf("Expected [10:TEN 30:thirty], got %s", m.String())
+		}
+
+		m.Clear()
+		if !m.IsEmpty() || m.String() != "[]" {
+			t.Error("Map should be empty after Clear")
+		}
+	})
+
+	t.Run("Navigation", func(t *testing.T) {
+		m := tree.NewTreeMap[int, int]()
+		for _, k := range []int{50, 10, 40, 20, 30} {
+			m.Put(k, k*10)
+		}
+
+		if k, v, ok := m.Min(); !ok || k != 10 || v != 100 {
+			t.Errorf("Min should be 10:100, got %d:%d", k, v)
+		}
+		if k, _, ok := m.Max(); !ok || k != 50 {
+			t.Errorf("Max should be 50, got %d", k)
+		}
+
+		cases := []struct {
+			name  string
+			fn    func(int) (int, int, bool)
+			input int
+			want  int
+			ok    bool
+		}{
+			{"Floor exact", m.Floor, 30, 30, true},
+			{"Floor between", m.Floor, 35, 30, true},
+			{"Floor below min", m.Floor, 5, 0, false},
+			{"Ceiling exact", m.Ceiling, 30, 30, true},
+			{"Ceiling between", m.Ceiling, 35, 40, true},
+			{"Ceiling above max", m.Ceiling, 55, 0, false},
+			{"Lower exact", m.Lower, 30, 20, true},
+			{"Lower min", m.Lower, 10, 0, false},
+			{"Higher exact", m.Higher, 30, 40, true},
+			{"Higher max", m.Higher, 50, 0, false},
+		}
+		for _, c := range cases {
+			k, _, ok := c.fn(c.input)
+			if ok != c.ok || (ok && k != c.want) {
+				t.Errorf("%s(%d): expected %d/%v, got %d/%v", c.name, c.input, c.want, c.ok, k, ok)
+			}
+		}
+
+		empty := tree.NewTreeMap[int, int]()
+		if _, _, ok := empty.Min(); ok {
+			t.Error("Min on empty map should report false")
+		}
+		if _, _, ok := empty.Max(); ok {
+			t.Error("Max on empty map should report false")
+		}
+	})
+
+	t.Run("Iteration", func(t *testing.T) {
+		m := tree.NewTreeMap[int, int]()
+		for _, k := range []int{5, 3, 8, 1, 4, 7, 9} {
+			m.Put(k, k)
+		}
+
+		if keys := slices.Collect(m.Keys()); !slices.Equal(keys, []int{1, 3, 4, 5, 7, 8, 9}) {
+			t.Errorf("Keys should be ascending, got %v", keys)
+		}
+		if values := slices.Collect(m.Values()); !slices.Equal(values, []int{1, 3, 4, 5, 7, 8, 9}) {
+			t.Errorf("Values should follow key order, got %v", values)
+		}
+
+		var backward []int
+		for k := range m.Backward() {
+			backward = append(backward, k)
+		}
+		if !slices.Equal(backward, []int{9, 8, 7, 5, 4, 3, 1}) {
+			t.Errorf("Backward should be descending, got %v", backward)
+		}
+
+		var ranged []int
+		for k := range m.Range(3, 7) {
+			ranged = append(ranged, k)
+		}
+		if !slices.Equal(ranged, []int{3, 4, 5, 7}) {
+			t.Errorf("Range(3, 7) should be [3 4 5 7], got %v", ranged)
+		}
+
+		var partial []int
+		for k := range m.Range(2, 100) {
+			partial = append(partial, k)
+			if len(partial) == 2 {
+				break
+			}
+		}
+		if !slices.Equal(partial, []int{3, 4}) {
+			t.Errorf("Range should stop early, got %v", partial)
+		}
+	})
+
+	t.Run("Custom Comparator", func(t *testing.T) {
+		m := tree.NewTreeMapFunc[string, int](func(a, b string) int {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		})
+		m.Put("Banana", 1)
+		m.Put("apple", 2)
+		m.Put("APPLE", 3)
+
+		if m.Size() != 2 {
+			t.Errorf("Case-insensitive keys should collapse, got size %d", m.Size())
+		}
+		if k, v, _ := m.Min(); k != "apple" || v != 3 {
+			t.Errorf("Expected apple:3, got %s:%d", k, v)
+		}
+	})
+
+	t.Run("Random Operations Match Reference", func(t *testing.T) {
+		m := tree.NewTreeMap[int, int]()
+		reference := map[int]int{}
+		rng := rand.New(rand.NewSource(1))
+
+		for range 5000 {
+			k := rng.Intn(500)
+			if rng.Intn(3) == 0 {
+				_, present := reference[k]
+				if m.Delete(k) != present {
+					t.Fatalf("Delete(%d) disagreed with reference", k)
+				}
+				delete(reference, k)
+			} else {
+				m.Put(k, k)
+				reference[k] = k
+			}
+		}
+
+		if m.Size() != len(reference) {
+			t.Fatalf("Expected size %d, got %d", len(reference), m.Size())
+		}
+		keys := slices.Collect(m.Keys())
+		if !slices.IsSorted(keys) || len(keys) != len(reference) {
+			t.Error("Keys should be sorted and complete")
+		}
+	})
+}
+
+func TestTreeSet(t *testing.T) {
+	t.Run("Basic Operations", func(t *testing.T) {
+		set := tree.FromTreeSetSlice([]int{5, 1, 3, 5, 9})
+
+		if set.Size() != 4 {
+			t.Errorf("Set size should be 4, got %d", set.Size())
+		}
+		if set.Add(3) {
+			t.Error("Add should return false for an existing element")
+		}
+		if !set.Add(7) {
+			t.Error("Add should return true for a new element")
+		}
+		if !set.Remove(1) || set.Remove(1) {
+			t.Error("Remove should report presence correctly")
+		}
+		if !set.Contains(7) || set.Contains(1) {
+			t.Error("Contains disagrees with Add/Remove")
+		}
+		if !slices.Equal(set.ToSlice(), []int{3, 5, 7, 9}) {
+			t.Errorf("Expected [3 5 7 9], got %v", set.ToSlice())
+		}
+		if set.String() != "[3 5 7 9]" {
+			t.Errorf("Expected [3 5 7 9], got %s", set.String())
+		}
+	})
+
+	t.Run("Navigation and Iteration", func(t *testing.T) {
+		set := tree.FromTreeSetSlice([]int{10, 20, 30, 40})
+
+		if v, _ := set.Min(); v != 10 {
+			t.Errorf("Min should be 10, got %d", v)
+		}
+		if v, _ := set.Max(); v != 40 {
+			t.Errorf("Max should be 40, got %d", v)
+		}
+		if v, _ := set.Floor(25); v != 20 {
+			t.Errorf("Floor(25) should be 20, got %d", v)
+		}
+		if v, _ := set.Ceiling(25); v != 30 {
+			t.Errorf("Ceiling(25) should be 30, got %d", v)
+		}
+		if v, _ := set.Lower(20); v != 10 {
+			t.Errorf("Lower(20) should be 10, got %d", v)
+		}
+		if v, _ := set.Higher(20); v != 30 {
+			t.Errorf("Higher(20) should be 30, got %d", v)
+		}
+		if _, ok := set.Higher(40); ok {
+			t.Error("Higher(40) should report false")
+		}
+
+		if r := slices.Collect(set.Range(15, 35)); !slices.Equal(r, []int{20, 30}) {
+			t.Errorf("Range(15, 35) should be [20 30], got %v", r)
+		}
+		if r := slices.Collect(set.Backward()); !slices.Equal(r, []int{40, 30, 20, 10}) {
+			t.Errorf("Backward should be descending, got %v", r)
+		}
+		if r := slices.Collect(set.All()); !slices.Equal(r, []int{10, 20, 30, 40}) {
+			t.Errorf("All should be ascending, got %v", r)
+		}
+
+		set.Clear()
+		if !set.IsEmpty() || set.String() != "[]" {
+			t.Error("Set should be empty after Clear")
+		}
+	})
+
+	t.Run("Custom Comparator", func(t *testing.T) {
+		set := tree.NewTreeSetFunc(func(a, b int) int { return b - a })
+		set.Add(1)
+		set.Add(3)
+		set.Add(2)
+
+		if !slices.Equal(set.ToSlice(), []int{3, 2, 1}) {
+			t.Errorf("Reverse comparator should sort descending, got %v", set.ToSlice())
+		}
+	})
+}
@@ -0,0 +1,240 @@
+package tests
+
+import (
+	"iter"
+	"math/rand"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/abhishekR-tech/collections/trie"
+)
+
+// prefixTree is the API shared by trie.Trie and trie.RadixTree
+type prefixTree interface {
+	Insert(key string, value int) bool
+	Get(key string) (int, bool)
+	Contains(key string) bool
+	Delete(key string) bool
+	HasPrefix(prefix string) bool
+	KeysWithPrefix(prefix string) iter.Seq[string]
+	LongestPrefixOf(s string) (string, int, bool)
+	Match(pattern string) iter.Seq[string]
+	Keys() []string
+	Size() int
+	IsEmpty() bool
+	Clear()
+	String() string
+}
+
+func prefixTrees() map[string]func() prefixTree {
+	return map[string]func() prefixTree{
+		"Trie":          func() prefixTree { return trie.NewTrie[int]() },
+		"RuneTrie":      func() prefixTree { return trie.NewRuneTrie[int]() },
+		"RadixTree":     func() prefixTree { return trie.NewRadixTree[int]() },
+		"RuneRadixTree": func() prefixTree { return trie.NewRuneRadixTree[int]() },
+	}
+}
+
+func TestPrefixTrees(t *testing.T) {
+	words := []string{"tea", "ten", "team", "to", "inn", "in", "i", "tent"}
+
+	for name, newTree := range prefixTrees() {
+		t.Run(name+" Insert, Get and Delete", func(t *testing.T) {
+			tr := newTree()
+			if !tr.IsEmpty() {
+				t.Error("New tree should be empty")
+			}
+
+			for i, w := range words {
+				if !tr.Insert(w, i) {
+					t.Errorf("Insert(%q) should report a new key", w)
+				}
+			}
+			if tr.Insert("tea", 100) {
+				t.Error("Insert of an existing key should return false")
+			}
+			if tr.Size() != len(words) {
+				t.Errorf("Expected size %d, got %d", len(words), tr.Size())
+			}
+
+			if v, ok := tr.Get("tea"); !ok || v != 100 {
+				t.Errorf("Get('tea') should return 100, got %d", v)
+			}
+			if tr.Contains("te") {
+				t.Error("'te' is only a prefix and should not be a key")
+			}
+
+			if !tr.Delete("team") || tr.Delete("team") || tr.Delete("te") {
+				t.Error("Delete should report presence correctly")
+			}
+			if !tr.Contains("tea") || !tr.Contains("ten") || !tr.Contains("tent") {
+				t.Error("Deleting 'team' should not affect other keys")
+			}
+			if tr.Delete("ten"); !tr.Contains("tent") {
+				t.Error("Deleting 'ten' should keep 'tent'")
+			}
+
+			expected := []string{"i", "in", "inn", "tea", "tent", "to"}
+			if !slices.Equal(tr.Keys(), expected) {
+				t.Errorf("Expected keys %v, got %v", expected, tr.Keys())
+			}
+
+			tr.Clear()
+			if !tr.IsEmpty() || tr.String() != "[]" {
+				t.Error("Tree should be empty after Clear")
+			}
+		})
+
+		t.Run(name+" Prefix Queries", func(t *testing.T) {
+			tr := newTree()
+			for i, w := range words {
+				tr.Insert(w, i)
+			}
+
+			if !tr.HasPrefix("te") || !tr.HasPrefix("") || tr.HasPrefix("tx") || tr.HasPrefix("teams") {
+				t.Error("HasPrefix gave wrong answers")
+			}
+
+			if keys := slices.Collect(tr.KeysWithPrefix("te")); !slices.Equal(keys, []string{"tea", "team", "ten", "tent"}) {
+				t.Errorf("KeysWithPrefix('te') should be [tea team ten tent], got %v", keys)
+			}
+			if keys := slices.Collect(tr.KeysWithPrefix("tean")); len(keys) != 0 {
+				t.Errorf("KeysWithPrefix('tean') should be empty, got %v", keys)
+			}
+
+			key, value, ok := tr.LongestPrefixOf("innkeeper")
+			if !ok || key != "inn" || value != 4 {
+				t.Errorf("LongestPrefixOf('innkeeper') should be inn:4, got %s:%d", key, value)
+			}
+			if key, _, ok := tr.LongestPrefixOf("teapot"); !ok || key != "tea" {
+				t.Errorf("LongestPrefixOf('teapot') should be tea, got %s", key)
+			}
+			if _, _, ok := tr.LongestPrefixOf("xyz"); ok {
+				t.Error("LongestPrefixOf('xyz') should report false")
+			}
+		})
+
+		t.Run(name+" Wildcard Match", func(t *testing.T) {
+			tr := newTree()
+			for i, w := range words {
+				tr.Insert(w, i)
+			}
+
+			cases := map[string][]string{
+				"te?":   {"tea", "ten"},
+				"te*":   {"tea", "team", "ten", "tent"},
+				"*n":    {"in", "inn", "ten"},
+				"?":     {"i"},
+				"t**t":  {"tent"},
+				"*":     {"i", "in", "inn", "tea", "team", "ten", "tent", "to"},
+				"??":    {"in", "to"},
+				"x*":    {},
+				"t?a*m": {"team"},
+			}
+			for pattern, want := range cases {
+				got := slices.Collect(tr.Match(pattern))
+				if !slices.Equal(got, want) && !(len(got) == 0 && len(want) == 0) {
+					t.Errorf("Match(%q): expected %v, got %v", pattern, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRuneKeys(t *testing.T) {
+	byteTrie := trie.NewTrie[int]()
+	runeTrie := trie.NewRuneTrie[int]()
+	byteRadix := trie.NewRadixTree[int]()
+	runeRadix := trie.NewRuneRadixTree[int]()
+
+	for _, tr := range []prefixTree{byteTrie, runeTrie, byteRadix, runeRadix} {
+		tr.Insert("café", 1)
+		tr.Insert("cafe", 2)
+		tr.Insert("日本", 3)
+		tr.Insert("日本語", 4)
+	}
+
+	// "é" and "日" are single runes but several bytes
+	if got := slices.Collect(runeTrie.Match("caf?")); !slices.Equal(got, []string{"cafe", "café"}) {
+		t.Errorf("Rune trie should match é as one unit, got %v", got)
+	}
+	if got := slices.Collect(byteTrie.Match("caf?")); !slices.Equal(got, []string{"cafe"}) {
+		t.Errorf("Byte trie should match é as two units, got %v", got)
+	}
+	if got := slices.Collect(runeRadix.Match("日?")); !slices.Equal(got, []string{"日本"}) {
+		t.Errorf("Rune radix tree should match 日本, got %v", got)
+	}
+	if got := slices.Collect(byteRadix.Match("日???")); !slices.Equal(got, []string{"日本"}) {
+		t.Errorf("Byte radix tree should match 本 as three units, got %v", got)
+	}
+
+	for _, tr := range []prefixTree{byteTrie, runeTrie, byteRadix, runeRadix} {
+		if got := slices.Collect(tr.KeysWithPrefix("日")); !slices.Equal(got, []string{"日本", "日本語"}) {
+			t.Errorf("KeysWithPrefix('日') should be [日本 日本語], got %v", got)
+		}
+		if key, _, _ := tr.LongestPrefixOf("日本語です"); key != "日本語" {
+			t.Errorf("LongestPrefixOf should return 日本語, got %s", key)
+		}
+	}
+}
+
+func TestPrefixTreesRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	alphabet := []string{"a", "b", "c", "é"}
+	randomKey := func() string {
+		var sb strings.Builder
+		for range rng.Intn(6) {
+			sb.WriteString(alphabet[rng.Intn(len(alphabet))])
+		}
+		return sb.String()
+	}
+
+	for name, newTree := range prefixTrees() {
+		t.Run(name, func(t *testing.T) {
+			tr := newTree()
+			reference := map[string]int{}
+
+			for i := range 4000 {
+				key := randomKey()
+				if rng.Intn(3) == 0 {
+					_, present := reference[key]
+					if tr.Delete(key) != present {
+						t.Fatalf("Delete(%q) disagreed with reference", key)
+					}
+					delete(reference, key)
+				} else {
+					tr.Insert(key, i)
+					reference[key] = i
+				}
+			}
+
+			want := make([]string, 0, len(reference))
+			for k := range reference {
+				want = append(want, k)
+			}
+			sort.Strings(want)
+
+			if !slices.Equal(tr.Keys(), want) {
+				t.Fatalf("Keys disagree with reference")
+			}
+			for k, v := range reference {
+				if got, ok := tr.Get(k); !ok || got != v {
+					t.Fatalf("Get(%q): expected %d, got %d", k, v, got)
+				}
+			}
+
+			prefix := "ab"
+			var withPrefix []string
+			for _, k := range want {
+				if strings.HasPrefix(k, prefix) {
+					withPrefix = append(withPrefix, k)
+				}
+			}
+			if got := slices.Collect(tr.KeysWithPrefix(prefix)); !slices.Equal(got, withPrefix) {
+				t.Fatalf("KeysWithPrefix(%q): expected %v, got %v", prefix, withPrefix, got)
+			}
+		})
+	}
+}
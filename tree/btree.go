@@ -0,0 +1,582 @@
+package tree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// cowContext marks which tree owns a node. Nodes reachable from several
+// clones are shared until one of them writes, at which point the writer
+// copies the node into its own context.
+type cowContext struct {
+	// The field keeps contexts non-zero-sized so each allocation is distinct
+	_ byte
+}
+
+// btreeNode represents a node of a BTree. Leaves have no children; internal
+// nodes have exactly one more child than items.
+type btreeNode[T any] struct {
+	items    []T
+	children []*btreeNode[T]
+	cow      *cowContext
+}
+
+// removal selects what BTree.remove deletes
+type removal int
+
+const (
+	removeItem removal = iota
+	removeMin
+	removeMax
+)
+
+// BTree represents an in-memory B-tree of ordered items.
+// Each node holds between degree-1 and 2*degree-1 items, which keeps the
+// tree shallow and its items contiguous in memory. Clone is O(1): clones
+// share nodes and copy them lazily on write.
+type BTree[T any] struct {
+	degree  int
+	length  int
+	root    *btreeNode[T]
+	compare func(a, b T) int
+	cow     *cowContext
+}
+
+// NewBTree creates a new empty B-tree of the given degree ordered by the natural order of T.
+// It panics if degree is less than 2.
+func NewBTree[T constraints.Ordered](degree int) *BTree[T] {
+	return NewBTreeFunc(degree, cmp.Compare[T])
+}
+
+// NewBTreeFunc creates a new empty B-tree of the given degree ordered by compare.
+// It panics if degree is less than 2.
+func NewBTreeFunc[T any](degree int, compare func(a, b T) int) *BTree[T] {
+	if degree < 2 {
+		panic("tree: degree must be at least 2")
+	}
+	return &BTree[T]{
+		degree:  degree,
+		compare: compare,
+		cow:     &cowContext{},
+	}
+}
+
+// Insert adds item to the tree, replacing any equal item.
+// It returns true if the item was not already present.
+func (t *BTree[T]) Insert(item T) bool {
+	if t.root == nil {
+		t.root = &btreeNode[T]{items: []T{item}, cow: t.cow}
+		t.length++
+		return true
+	}
+
+	t.root = t.root.mutableFor(t.cow)
+	if len(t.root.items) >= t.maxItems() {
+		middle, right := t.root.split(t.maxItems() / 2)
+		left := t.root
+		t.root = &btreeNode[T]{
+			items:    []T{middle},
+			children: []*btreeNode[T]{left, right},
+			cow:      t.cow,
+		}
+	}
+
+	added := t.root.insert(item, t.maxItems(), t.compare)
+	if added {
+		t.length++
+	}
+	return added
+}
+
+// LoadSorted fills an empty tree from items sorted in strictly ascending order.
+// It builds the tree bottom-up in O(n), which is much faster than inserting one by one.
+func (t *BTree[T]) LoadSorted(items []T) error {
+	if t.root != nil {
+		return errors.New("tree is not empty")
+	}
+	for i := 1; i < len(items); i++ {
+		if t.compare(items[i-1], items[i]) >= 0 {
+			return errors.New("items are not in strictly ascending order")
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	// Find the shortest height whose full tree can hold every item
+	height, capacity := 1, t.maxItems()
+	for capacity < len(items) {
+		height++
+		capacity = capacity*2*t.degree + 2*t.degree - 1
+	}
+
+	t.root = t.build(items, height, true)
+	t.length = len(items)
+	return nil
+}
+
+// Get returns the stored item equal to key
+func (t *BTree[T]) Get(key T) (T, bool) {
+	n := t.root
+	for n != nil {
+		i, found := n.find(key, t.compare)
+		if found {
+			return n.items[i], true
+		}
+		if len(n.children) == 0 {
+			break
+		}
+		n = n.children[i]
+	}
+	return *new(T), false
+}
+
+// Has returns true if an item equal to key is in the tree
+func (t *BTree[T]) Has(key T) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Delete removes the item equal to key and returns it
+func (t *BTree[T]) Delete(key T) (T, bool) {
+	return t.remove(key, removeItem)
+}
+
+// DeleteMin removes and returns the smallest item
+func (t *BTree[T]) DeleteMin() (T, bool) {
+	return t.remove(*new(T), removeMin)
+}
+
+// DeleteMax removes and returns the largest item
+func (t *BTree[T]) DeleteMax() (T, bool) {
+	return t.remove(*new(T), removeMax)
+}
+
+// Min returns the smallest item
+func (t *BTree[T]) Min() (T, bool) {
+	if t.root == nil {
+		return *new(T), false
+	}
+	n := t.root
+	for len(n.children) > 0 {
+		n = n.children[0]
+	}
+	return n.items[0], true
+}
+
+// Max returns the largest item
+func (t *BTree[T]) Max() (T, bool) {
+	if t.root == nil {
+		return *new(T), false
+	}
+	n := t.root
+	for len(n.children) > 0 {
+		n = n.children[len(n.children)-1]
+	}
+	return n.items[len(n.items)-1], true
+}
+
+// All returns an iterator over all items in ascending order
+func (t *BTree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t.root != nil {
+			t.root.ascend(nil, t.compare, yield)
+		}
+	}
+}
+
+// Backward returns an iterator over all items in descending order
+func (t *BTree[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t.root != nil {
+			t.root.descend(nil, t.compare, yield)
+		}
+	}
+}
+
+// AscendFrom returns an iterator over the items greater than or equal to pivot in ascending order
+func (t *BTree[T]) AscendFrom(pivot T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t.root != nil {
+			t.root.ascend(&pivot, t.compare, yield)
+		}
+	}
+}
+
+// DescendFrom returns an iterator over the items less than or equal to pivot in descending order
+func (t *BTree[T]) DescendFrom(pivot T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t.root != nil {
+			t.root.descend(&pivot, t.compare, yield)
+		}
+	}
+}
+
+// Clone returns a snapshot of the tree in O(1).
+// The original and the clone share nodes until either is modified, and
+// writes to one are never visible in the other.
+func (t *BTree[T]) Clone() *BTree[T] {
+	// Both trees get fresh contexts so neither can mutate the shared nodes in place
+	clone := *t
+	t.cow = &cowContext{}
+	clone.cow = &cowContext{}
+	return &clone
+}
+
+// Degree returns the degree of the tree
+func (t *BTree[T]) Degree() int {
+	return t.degree
+}
+
+// Size returns the number of items in the tree
+func (t *BTree[T]) Size() int {
+	return t.length
+}
+
+// IsEmpty returns true if the tree has no items
+func (t *BTree[T]) IsEmpty() bool {
+	return t.length == 0
+}
+
+// Clear removes all items from the tree
+func (t *BTree[T]) Clear() {
+	t.root = nil
+	t.length = 0
+}
+
+// ToSlice returns all items in ascending order
+func (t *BTree[T]) ToSlice() []T {
+	result := make([]T, 0, t.length)
+	for item := range t.All() {
+		result = append(result, item)
+	}
+	return result
+}
+
+// String returns a string representation of the tree in ascending order
+func (t *BTree[T]) String() string {
+	if t.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for item := range t.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v", item))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// maxItems returns the maximum number of items per node
+func (t *BTree[T]) maxItems() int {
+	return 2*t.degree - 1
+}
+
+// minItems returns the minimum number of items per non-root node
+func (t *BTree[T]) minItems() int {
+	return t.degree - 1
+}
+
+// remove deletes an item of the given kind and collapses the root if it empties
+func (t *BTree[T]) remove(item T, kind removal) (T, bool) {
+	if t.root == nil {
+		return *new(T), false
+	}
+
+	t.root = t.root.mutableFor(t.cow)
+	out, removed := t.root.remove(item, t.minItems(), kind, t.compare)
+	if len(t.root.items) == 0 {
+		if len(t.root.children) > 0 {
+			t.root = t.root.children[0]
+		} else {
+			t.root = nil
+		}
+	}
+	if removed {
+		t.length--
+	}
+	return out, removed
+}
+
+// build creates a subtree of the given height holding the sorted items.
+// Children are made as full as possible while every node stays within bounds.
+func (t *BTree[T]) build(items []T, height int, isRoot bool) *btreeNode[T] {
+	n := &btreeNode[T]{cow: t.cow}
+	if height == 1 {
+		n.items = slices.Clone(items)
+		return n
+	}
+
+	// Full and minimal item counts of a subtree one level down
+	full, minimal := t.maxItems(), t.minItems()
+	for range height - 2 {
+		full = full*2*t.degree + 2*t.degree - 1
+		minimal = minimal*t.degree + t.degree - 1
+	}
+
+	count := (len(items) + 1 + full) / (full + 1)
+	minChildren := t.degree
+	if isRoot {
+		minChildren = 2
+	}
+	count = min(max(count, minChildren), (len(items)+1)/(minimal+1), 2*t.degree)
+
+	// Spread the items evenly, keeping one separator between neighbouring children
+	per, extra := (len(items)-(count-1))/count, (len(items)-(count-1))%count
+	start := 0
+	for i := range count {
+		size := per
+		if i < extra {
+			size++
+		}
+		n.children = append(n.children, t.build(items[start:start+size], height-1, false))
+		start += size
+		if i < count-1 {
+			n.items = append(n.items, items[start])
+			start++
+		}
+	}
+	return n
+}
+
+// mutableFor returns n if it belongs to cow, or a copy of n that does
+func (n *btreeNode[T]) mutableFor(cow *cowContext) *btreeNode[T] {
+	if n.cow == cow {
+		return n
+	}
+	return &btreeNode[T]{
+		items:    slices.Clone(n.items),
+		children: slices.Clone(n.children),
+		cow:      cow,
+	}
+}
+
+// mutableChild makes child i writable by n's context and returns it
+func (n *btreeNode[T]) mutableChild(i int) *btreeNode[T] {
+	child := n.children[i].mutableFor(n.cow)
+	n.children[i] = child
+	return child
+}
+
+// find returns the index of the first item not less than key and whether it equals key
+func (n *btreeNode[T]) find(key T, compare func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(n.items, key, compare)
+}
+
+// split moves the items after index i into a new node and returns the item at i and that node
+func (n *btreeNode[T]) split(i int) (T, *btreeNode[T]) {
+	item := n.items[i]
+	next := &btreeNode[T]{cow: n.cow}
+	next.items = append(next.items, n.items[i+1:]...)
+	clear(n.items[i:])
+	n.items = n.items[:i]
+	if len(n.children) > 0 {
+		next.children = append(next.children, n.children[i+1:]...)
+		clear(n.children[i+1:])
+		n.children = n.children[:i+1]
+	}
+	return item, next
+}
+
+// maybeSplitChild splits child i if it is full and returns true if it did
+func (n *btreeNode[T]) maybeSplitChild(i, maxItems int) bool {
+	if len(n.children[i].items) < maxItems {
+		return false
+	}
+	first := n.mutableChild(i)
+	item, second := first.split(maxItems / 2)
+	n.items = slices.Insert(n.items, i, item)
+	n.children = slices.Insert(n.children, i+1, second)
+	return true
+}
+
+// insert adds item to the subtree, splitting full nodes on the way down
+func (n *btreeNode[T]) insert(item T, maxItems int, compare func(a, b T) int) bool {
+	i, found := n.find(item, compare)
+	if found {
+		n.items[i] = item
+		return false
+	}
+	if len(n.children) == 0 {
+		n.items = slices.Insert(n.items, i, item)
+		return true
+	}
+	if n.maybeSplitChild(i, maxItems) {
+		c := compare(item, n.items[i])
+		if c == 0 {
+			n.items[i] = item
+			return false
+		}
+		if c > 0 {
+			i++
+		}
+	}
+	return n.mutableChild(i).insert(item, maxItems, compare)
+}
+
+// remove deletes an item of the given kind from the subtree, making sure
+// every child it descends into has more than the minimum number of items
+func (n *btreeNode[T]) remove(item T, minItems int, kind removal, compare func(a, b T) int) (T, bool) {
+	var i int
+	var found bool
+	switch kind {
+	case removeMax:
+		if len(n.children) == 0 {
+			last := n.items[len(n.items)-1]
+			n.items[len(n.items)-1] = *new(T)
+			n.items = n.items[:len(n.items)-1]
+			return last, true
+		}
+		i = len(n.items)
+	case removeMin:
+		if len(n.children) == 0 {
+			first := n.items[0]
+			n.items = slices.Delete(n.items, 0, 1)
+			return first, true
+		}
+		i = 0
+	case removeItem:
+		i, found = n.find(item, compare)
+		if len(n.children) == 0 {
+			if !found {
+				return *new(T), false
+			}
+			out := n.items[i]
+			n.items = slices.Delete(n.items, i, i+1)
+			return out, true
+		}
+	}
+
+	if len(n.children[i].items) <= minItems {
+		return n.growChildAndRemove(i, item, minItems, kind, compare)
+	}
+
+	child := n.mutableChild(i)
+	if found {
+		// Replace the item with its predecessor from the left subtree
+		out := n.items[i]
+		n.items[i], _ = child.remove(*new(T), minItems, removeMax, compare)
+		return out, true
+	}
+	return child.remove(item, minItems, kind, compare)
+}
+
+// growChildAndRemove gives child i an extra item by borrowing from a sibling
+// or merging with one, then retries the removal from n
+func (n *btreeNode[T]) growChildAndRemove(i int, item T, minItems int, kind removal, compare func(a, b T) int) (T, bool) {
+	switch {
+	case i > 0 && len(n.children[i-1].items) > minItems:
+		// Borrow from the left sibling through the separator
+		child := n.mutableChild(i)
+		left := n.mutableChild(i - 1)
+		child.items = slices.Insert(child.items, 0, n.items[i-1])
+		n.items[i-1] = left.items[len(left.items)-1]
+		left.items[len(left.items)-1] = *new(T)
+		left.items = left.items[:len(left.items)-1]
+		if len(left.children) > 0 {
+			child.children = slices.Insert(child.children, 0, left.children[len(left.children)-1])
+			left.children[len(left.children)-1] = nil
+			left.children = left.children[:len(left.children)-1]
+		}
+
+	case i < len(n.items) && len(n.children[i+1].items) > minItems:
+		// Borrow from the right sibling through the separator
+		child := n.mutableChild(i)
+		right := n.mutableChild(i + 1)
+		child.items = append(child.items, n.items[i])
+		n.items[i] = right.items[0]
+		right.items = slices.Delete(right.items, 0, 1)
+		if len(right.children) > 0 {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+
+	default:
+		// Merge the child with a sibling around their separator
+		if i >= len(n.items) {
+			i--
+		}
+		child := n.mutableChild(i)
+		sibling := n.children[i+1]
+		child.items = append(child.items, n.items[i])
+		child.items = append(child.items, sibling.items...)
+		child.children = append(child.children, sibling.children...)
+		n.items = slices.Delete(n.items, i, i+1)
+		n.children = slices.Delete(n.children, i+1, i+2)
+	}
+	return n.remove(item, minItems, kind, compare)
+}
+
+// ascend yields the items of the subtree that are not less than pivot, in ascending order.
+// A nil pivot yields every item.
+func (n *btreeNode[T]) ascend(pivot *T, compare func(a, b T) int, yield func(T) bool) bool {
+	i := 0
+	if pivot != nil {
+		var found bool
+		i, found = n.find(*pivot, compare)
+		// Child i only holds items below the pivot when the pivot itself is here
+		if !found && len(n.children) > 0 && !n.children[i].ascend(pivot, compare, yield) {
+			return false
+		}
+		if i < len(n.items) && !yield(n.items[i]) {
+			return false
+		}
+		i++
+	}
+
+	for ; i <= len(n.items); i++ {
+		if len(n.children) > 0 && !n.children[i].ascend(nil, compare, yield) {
+			return false
+		}
+		if i < len(n.items) && !yield(n.items[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// descend yields the items of the subtree that are not greater than pivot, in descending order.
+// A nil pivot yields every item.
+func (n *btreeNode[T]) descend(pivot *T, compare func(a, b T) int, yield func(T) bool) bool {
+	i := len(n.items)
+	if pivot != nil {
+		var found bool
+		i, found = n.find(*pivot, compare)
+		if found {
+			if !yield(n.items[i]) {
+				return false
+			}
+			if len(n.children) > 0 && !n.children[i].descend(nil, compare, yield) {
+				return false
+			}
+		} else if len(n.children) > 0 && !n.children[i].descend(pivot, compare, yield) {
+			return false
+		}
+	} else if len(n.children) > 0 && !n.children[i].descend(nil, compare, yield) {
+		return false
+	}
+
+	for j := i - 1; j >= 0; j-- {
+		if !yield(n.items[j]) {
+			return false
+		}
+		if len(n.children) > 0 && !n.children[j].descend(nil, compare, yield) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,305 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Interval represents the closed range of keys from Lo to Hi
+type Interval[K any] struct {
+	Lo K
+	Hi K
+}
+
+// String returns a string representation of the interval
+func (iv Interval[K]) String() string {
+	return fmt.Sprintf("[%v %v]", iv.Lo, iv.Hi)
+}
+
+// intervalNode represents a node of an IntervalTree.
+// maxHi is the largest upper bound in the subtree, used to prune searches.
+type intervalNode[K any, V any] struct {
+	interval Interval[K]
+	value    V
+	maxHi    K
+	height   int
+	left     *intervalNode[K, V]
+	right    *intervalNode[K, V]
+}
+
+// IntervalTree represents a map from closed intervals to values, backed by an
+// AVL tree ordered by lower then upper bound and augmented with subtree maxima.
+// Insertion and deletion are O(log n); overlap queries are O(log n + m) for m results.
+type IntervalTree[K any, V any] struct {
+	root    *intervalNode[K, V]
+	size    int
+	compare func(a, b K) int
+}
+
+// NewIntervalTree creates a new empty IntervalTree ordered by the natural order of K
+func NewIntervalTree[K constraints.Ordered, V any]() *IntervalTree[K, V] {
+	return NewIntervalTreeFunc[K, V](cmp.Compare[K])
+}
+
+// NewIntervalTreeFunc creates a new empty IntervalTree ordered by compare
+func NewIntervalTreeFunc[K any, V any](compare func(a, b K) int) *IntervalTree[K, V] {
+	return &IntervalTree[K, V]{
+		compare: compare,
+	}
+}
+
+// Insert associates value with the interval [lo, hi], replacing any previous value.
+// It returns true if the interval is new, and ignores intervals with lo > hi.
+func (t *IntervalTree[K, V]) Insert(lo, hi K, value V) bool {
+	if t.compare(lo, hi) > 0 {
+		return false
+	}
+	var added bool
+	t.root, added = t.insert(t.root, Interval[K]{Lo: lo, Hi: hi}, value)
+	if added {
+		t.size++
+	}
+	return added
+}
+
+// Get returns the value associated with exactly the interval [lo, hi]
+func (t *IntervalTree[K, V]) Get(lo, hi K) (V, bool) {
+	key := Interval[K]{Lo: lo, Hi: hi}
+	n := t.root
+	for n != nil {
+		c := t.compareIntervals(key, n.interval)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	return *new(V), false
+}
+
+// Delete removes exactly the interval [lo, hi] and returns true if it was present
+func (t *IntervalTree[K, V]) Delete(lo, hi K) bool {
+	var removed bool
+	t.root, removed = t.delete(t.root, Interval[K]{Lo: lo, Hi: hi})
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+// Overlapping returns an iterator over the intervals sharing at least one key
+// with [lo, hi], in ascending order
+func (t *IntervalTree[K, V]) Overlapping(lo, hi K) iter.Seq2[Interval[K], V] {
+	return func(yield func(Interval[K], V) bool) {
+		t.overlapping(t.root, lo, hi, yield)
+	}
+}
+
+// Containing returns an iterator over the intervals that contain point, in ascending order
+func (t *IntervalTree[K, V]) Containing(point K) iter.Seq2[Interval[K], V] {
+	return t.Overlapping(point, point)
+}
+
+// Overlaps returns true if any interval shares at least one key with [lo, hi]
+func (t *IntervalTree[K, V]) Overlaps(lo, hi K) bool {
+	for range t.Overlapping(lo, hi) {
+		return true
+	}
+	return false
+}
+
+// All returns an iterator over all intervals in ascending order
+func (t *IntervalTree[K, V]) All() iter.Seq2[Interval[K], V] {
+	return func(yield func(Interval[K], V) bool) {
+		t.root.ascend(yield)
+	}
+}
+
+// Size returns the number of intervals
+func (t *IntervalTree[K, V]) Size() int {
+	return t.size
+}
+
+// IsEmpty returns true if the tree has no intervals
+func (t *IntervalTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes all intervals from the tree
+func (t *IntervalTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// String returns a string representation of the tree in ascending order
+func (t *IntervalTree[K, V]) String() string {
+	if t.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for iv, v := range t.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v:%v", iv, v))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// compareIntervals orders intervals by lower bound, then by upper bound
+func (t *IntervalTree[K, V]) compareIntervals(a, b Interval[K]) int {
+	if c := t.compare(a.Lo, b.Lo); c != 0 {
+		return c
+	}
+	return t.compare(a.Hi, b.Hi)
+}
+
+// insert adds iv to the subtree rooted at n and returns the new root
+func (t *IntervalTree[K, V]) insert(n *intervalNode[K, V], iv Interval[K], value V) (*intervalNode[K, V], bool) {
+	if n == nil {
+		return &intervalNode[K, V]{interval: iv, value: value, maxHi: iv.Hi, height: 1}, true
+	}
+
+	var added bool
+	c := t.compareIntervals(iv, n.interval)
+	switch {
+	case c < 0:
+		n.left, added = t.insert(n.left, iv, value)
+	case c > 0:
+		n.right, added = t.insert(n.right, iv, value)
+	default:
+		n.value = value
+		return n, false
+	}
+	return t.rebalance(n), added
+}
+
+// delete removes iv from the subtree rooted at n and returns the new root
+func (t *IntervalTree[K, V]) delete(n *intervalNode[K, V], iv Interval[K]) (*intervalNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var removed bool
+	c := t.compareIntervals(iv, n.interval)
+	switch {
+	case c < 0:
+		n.left, removed = t.delete(n.left, iv)
+	case c > 0:
+		n.right, removed = t.delete(n.right, iv)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		// Replace with the in-order successor
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.interval, n.value = successor.interval, successor.value
+		n.right, _ = t.delete(n.right, successor.interval)
+		removed = true
+	}
+	return t.rebalance(n), removed
+}
+
+// overlapping yields the intervals of n overlapping [lo, hi], skipping subtrees that end before lo
+func (t *IntervalTree[K, V]) overlapping(n *intervalNode[K, V], lo, hi K, yield func(Interval[K], V) bool) bool {
+	if n == nil || t.compare(n.maxHi, lo) < 0 {
+		return true
+	}
+	if !t.overlapping(n.left, lo, hi, yield) {
+		return false
+	}
+	// Everything to the right starts at or after n, so nothing there can overlap
+	if t.compare(n.interval.Lo, hi) > 0 {
+		return true
+	}
+	if t.compare(n.interval.Hi, lo) >= 0 && !yield(n.interval, n.value) {
+		return false
+	}
+	return t.overlapping(n.right, lo, hi, yield)
+}
+
+// update recomputes the height and subtree maximum of n from its children
+func (t *IntervalTree[K, V]) update(n *intervalNode[K, V]) {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	n.maxHi = n.interval.Hi
+	for _, child := range []*intervalNode[K, V]{n.left, n.right} {
+		if child != nil && t.compare(child.maxHi, n.maxHi) > 0 {
+			n.maxHi = child.maxHi
+		}
+	}
+}
+
+// rotateLeft rotates n left and returns the new subtree root
+func (t *IntervalTree[K, V]) rotateLeft(n *intervalNode[K, V]) *intervalNode[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	t.update(n)
+	t.update(r)
+	return r
+}
+
+// rotateRight rotates n right and returns the new subtree root
+func (t *IntervalTree[K, V]) rotateRight(n *intervalNode[K, V]) *intervalNode[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	t.update(n)
+	t.update(l)
+	return l
+}
+
+// rebalance restores the AVL property at n and returns the new subtree root
+func (t *IntervalTree[K, V]) rebalance(n *intervalNode[K, V]) *intervalNode[K, V] {
+	t.update(n)
+	balance := n.left.getHeight() - n.right.getHeight()
+	if balance > 1 {
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = t.rotateLeft(n.left)
+		}
+		return t.rotateRight(n)
+	}
+	if balance < -1 {
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = t.rotateRight(n.right)
+		}
+		return t.rotateLeft(n)
+	}
+	return n
+}
+
+// getHeight returns the height of the subtree, treating nil as 0
+func (n *intervalNode[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// ascend yields the intervals of the subtree in ascending order
+func (n *intervalNode[K, V]) ascend(yield func(Interval[K], V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.left.ascend(yield) && yield(n.interval, n.value) && n.right.ascend(yield)
+}
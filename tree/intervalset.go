@@ -0,0 +1,163 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// IntervalSet represents a set of keys stored as disjoint half-open ranges [lo, hi).
+// Overlapping and adjacent ranges are merged as they are added, so the set always
+// holds the fewest ranges covering its keys. It is backed by a TreeMap from lo to hi.
+type IntervalSet[K any] struct {
+	ranges  *TreeMap[K, K]
+	compare func(a, b K) int
+}
+
+// NewIntervalSet creates a new empty IntervalSet ordered by the natural order of K
+func NewIntervalSet[K constraints.Ordered]() *IntervalSet[K] {
+	return NewIntervalSetFunc(cmp.Compare[K])
+}
+
+// NewIntervalSetFunc creates a new empty IntervalSet ordered by compare
+func NewIntervalSetFunc[K any](compare func(a, b K) int) *IntervalSet[K] {
+	return &IntervalSet[K]{
+		ranges:  NewTreeMapFunc[K, K](compare),
+		compare: compare,
+	}
+}
+
+// Add inserts the keys in [lo, hi), merging with any range it overlaps or touches.
+// Empty ranges with lo >= hi are ignored.
+func (s *IntervalSet[K]) Add(lo, hi K) {
+	if s.compare(lo, hi) >= 0 {
+		return
+	}
+	// Extend left over a range that reaches lo
+	if start, end, ok := s.ranges.Floor(lo); ok && s.compare(end, lo) >= 0 {
+		lo = start
+		if s.compare(end, hi) > 0 {
+			hi = end
+		}
+	}
+	// Absorb every range starting inside or right at the end of [lo, hi]
+	var absorbed []K
+	for start, end := range s.ranges.Range(lo, hi) {
+		absorbed = append(absorbed, start)
+		if s.compare(end, hi) > 0 {
+			hi = end
+		}
+	}
+	for _, start := range absorbed {
+		s.ranges.Delete(start)
+	}
+	s.ranges.Put(lo, hi)
+}
+
+// Remove deletes the keys in [lo, hi), splitting any range that straddles a boundary.
+// Empty ranges with lo >= hi are ignored.
+func (s *IntervalSet[K]) Remove(lo, hi K) {
+	if s.compare(lo, hi) >= 0 {
+		return
+	}
+	// Trim a range that starts before lo and reaches into [lo, hi)
+	if start, end, ok := s.ranges.Lower(lo); ok && s.compare(end, lo) > 0 {
+		s.ranges.Put(start, lo)
+		if s.compare(end, hi) > 0 {
+			s.ranges.Put(hi, end)
+			return
+		}
+	}
+	// Drop ranges starting inside [lo, hi), keeping any part past hi
+	var dropped []K
+	var tail K
+	hasTail := false
+	for start, end := range s.ranges.Range(lo, hi) {
+		if s.compare(start, hi) == 0 {
+			break
+		}
+		dropped = append(dropped, start)
+		if s.compare(end, hi) > 0 {
+			tail, hasTail = end, true
+		}
+	}
+	for _, start := range dropped {
+		s.ranges.Delete(start)
+	}
+	if hasTail {
+		s.ranges.Put(hi, tail)
+	}
+}
+
+// Contains returns true if key is in the set
+func (s *IntervalSet[K]) Contains(key K) bool {
+	_, end, ok := s.ranges.Floor(key)
+	return ok && s.compare(key, end) < 0
+}
+
+// ContainsRange returns true if every key in [lo, hi) is in the set.
+// An empty range is always contained.
+func (s *IntervalSet[K]) ContainsRange(lo, hi K) bool {
+	if s.compare(lo, hi) >= 0 {
+		return true
+	}
+	_, end, ok := s.ranges.Floor(lo)
+	return ok && s.compare(hi, end) <= 0
+}
+
+// Overlaps returns true if any key in [lo, hi) is in the set
+func (s *IntervalSet[K]) Overlaps(lo, hi K) bool {
+	if s.compare(lo, hi) >= 0 {
+		return false
+	}
+	if _, end, ok := s.ranges.Floor(lo); ok && s.compare(lo, end) < 0 {
+		return true
+	}
+	start, _, ok := s.ranges.Higher(lo)
+	return ok && s.compare(start, hi) < 0
+}
+
+// All returns an iterator over the ranges as (lo, hi) pairs in ascending order
+func (s *IntervalSet[K]) All() iter.Seq2[K, K] {
+	return s.ranges.All()
+}
+
+// Size returns the number of disjoint ranges
+func (s *IntervalSet[K]) Size() int {
+	return s.ranges.Size()
+}
+
+// IsEmpty returns true if the set has no keys
+func (s *IntervalSet[K]) IsEmpty() bool {
+	return s.ranges.IsEmpty()
+}
+
+// Clear removes all ranges from the set
+func (s *IntervalSet[K]) Clear() {
+	s.ranges.Clear()
+}
+
+// String returns a string representation of the ranges in ascending order
+func (s *IntervalSet[K]) String() string {
+	if s.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for lo, hi := range s.ranges.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("[%v %v)", lo, hi))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
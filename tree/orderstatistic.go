@@ -0,0 +1,333 @@
+package tree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// statNode represents a node of an OrderStatisticTree.
+// Equal items share a node; size counts every occurrence in the subtree.
+type statNode[T any] struct {
+	item   T
+	count  int
+	size   int
+	height int
+	left   *statNode[T]
+	right  *statNode[T]
+}
+
+// OrderStatisticTree represents a sorted multiset backed by a size-augmented AVL tree.
+// Besides O(log n) insertion and deletion it answers rank and select queries in O(log n).
+type OrderStatisticTree[T any] struct {
+	root    *statNode[T]
+	compare func(a, b T) int
+}
+
+// NewOrderStatisticTree creates a new empty tree ordered by the natural order of T
+func NewOrderStatisticTree[T constraints.Ordered]() *OrderStatisticTree[T] {
+	return NewOrderStatisticTreeFunc(cmp.Compare[T])
+}
+
+// NewOrderStatisticTreeFunc creates a new empty tree ordered by compare
+func NewOrderStatisticTreeFunc[T any](compare func(a, b T) int) *OrderStatisticTree[T] {
+	return &OrderStatisticTree[T]{
+		compare: compare,
+	}
+}
+
+// FromOrderStatisticTreeSlice creates a new tree from the items of a slice
+func FromOrderStatisticTreeSlice[T constraints.Ordered](slice []T) *OrderStatisticTree[T] {
+	t := NewOrderStatisticTree[T]()
+	for _, item := range slice {
+		t.Insert(item)
+	}
+	return t
+}
+
+// Insert adds one occurrence of item
+func (t *OrderStatisticTree[T]) Insert(item T) {
+	t.root = t.insert(t.root, item)
+}
+
+// Delete removes one occurrence of item and returns true if it was present
+func (t *OrderStatisticTree[T]) Delete(item T) bool {
+	var removed bool
+	t.root, removed = t.delete(t.root, item)
+	return removed
+}
+
+// Count returns the number of occurrences of item
+func (t *OrderStatisticTree[T]) Count(item T) int {
+	n := t.root
+	for n != nil {
+		c := t.compare(item, n.item)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.count
+		}
+	}
+	return 0
+}
+
+// Contains returns true if at least one occurrence of item is present
+func (t *OrderStatisticTree[T]) Contains(item T) bool {
+	return t.Count(item) > 0
+}
+
+// Rank returns the number of items strictly less than item
+func (t *OrderStatisticTree[T]) Rank(item T) int {
+	rank := 0
+	n := t.root
+	for n != nil {
+		c := t.compare(item, n.item)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += n.left.getSize() + n.count
+			n = n.right
+		default:
+			return rank + n.left.getSize()
+		}
+	}
+	return rank
+}
+
+// Select returns the item at index k of the sorted order, counting from 0
+func (t *OrderStatisticTree[T]) Select(k int) (T, error) {
+	if k < 0 || k >= t.Size() {
+		return *new(T), errors.New("index out of bounds")
+	}
+
+	n := t.root
+	for {
+		leftSize := n.left.getSize()
+		switch {
+		case k < leftSize:
+			n = n.left
+		case k < leftSize+n.count:
+			return n.item, nil
+		default:
+			k -= leftSize + n.count
+			n = n.right
+		}
+	}
+}
+
+// CountRange returns the number of items with lo <= item <= hi
+func (t *OrderStatisticTree[T]) CountRange(lo, hi T) int {
+	if t.compare(lo, hi) > 0 {
+		return 0
+	}
+	return t.Rank(hi) + t.Count(hi) - t.Rank(lo)
+}
+
+// Min returns the smallest item
+func (t *OrderStatisticTree[T]) Min() (T, error) {
+	return t.Select(0)
+}
+
+// Max returns the largest item
+func (t *OrderStatisticTree[T]) Max() (T, error) {
+	return t.Select(t.Size() - 1)
+}
+
+// All returns an iterator over every occurrence in ascending order
+func (t *OrderStatisticTree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.root.ascend(yield)
+	}
+}
+
+// Size returns the number of items, counting duplicates
+func (t *OrderStatisticTree[T]) Size() int {
+	return t.root.getSize()
+}
+
+// IsEmpty returns true if the tree has no items
+func (t *OrderStatisticTree[T]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Clear removes all items from the tree
+func (t *OrderStatisticTree[T]) Clear() {
+	t.root = nil
+}
+
+// ToSlice returns every occurrence in ascending order
+func (t *OrderStatisticTree[T]) ToSlice() []T {
+	result := make([]T, 0, t.Size())
+	for item := range t.All() {
+		result = append(result, item)
+	}
+	return result
+}
+
+// String returns a string representation of the tree in ascending order
+func (t *OrderStatisticTree[T]) String() string {
+	if t.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for item := range t.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v", item))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// insert adds item to the subtree rooted at n and returns the new root
+func (t *OrderStatisticTree[T]) insert(n *statNode[T], item T) *statNode[T] {
+	if n == nil {
+		return &statNode[T]{item: item, count: 1, size: 1, height: 1}
+	}
+
+	c := t.compare(item, n.item)
+	switch {
+	case c < 0:
+		n.left = t.insert(n.left, item)
+	case c > 0:
+		n.right = t.insert(n.right, item)
+	default:
+		n.count++
+	}
+	return n.rebalance()
+}
+
+// delete removes one occurrence of item from the subtree rooted at n and returns the new root
+func (t *OrderStatisticTree[T]) delete(n *statNode[T], item T) (*statNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var removed bool
+	c := t.compare(item, n.item)
+	switch {
+	case c < 0:
+		n.left, removed = t.delete(n.left, item)
+	case c > 0:
+		n.right, removed = t.delete(n.right, item)
+	default:
+		removed = true
+		if n.count > 1 {
+			n.count--
+			break
+		}
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		// Replace with the in-order successor, moving all of its occurrences
+		var successor *statNode[T]
+		n.right, successor = n.right.removeMin()
+		n.item, n.count = successor.item, successor.count
+	}
+	return n.rebalance(), removed
+}
+
+// removeMin detaches the leftmost node of the subtree and returns the new root and that node
+func (n *statNode[T]) removeMin() (*statNode[T], *statNode[T]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var smallest *statNode[T]
+	n.left, smallest = n.left.removeMin()
+	return n.rebalance(), smallest
+}
+
+// ascend yields every occurrence in the subtree in ascending order
+func (n *statNode[T]) ascend(yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	if !n.left.ascend(yield) {
+		return false
+	}
+	for range n.count {
+		if !yield(n.item) {
+			return false
+		}
+	}
+	return n.right.ascend(yield)
+}
+
+// getSize returns the number of occurrences in the subtree, treating nil as empty
+func (n *statNode[T]) getSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// getHeight returns the height of the subtree, treating nil as 0
+func (n *statNode[T]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the height and size of n from its children
+func (n *statNode[T]) update() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	n.size = n.count + n.left.getSize() + n.right.getSize()
+}
+
+// rotateLeft rotates n left and returns the new subtree root
+func (n *statNode[T]) rotateLeft() *statNode[T] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+// rotateRight rotates n right and returns the new subtree root
+func (n *statNode[T]) rotateRight() *statNode[T] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+// rebalance restores the AVL property at n and returns the new subtree root
+func (n *statNode[T]) rebalance() *statNode[T] {
+	n.update()
+	balance := n.left.getHeight() - n.right.getHeight()
+	if balance > 1 {
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	}
+	if balance < -1 {
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
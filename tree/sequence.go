@@ -0,0 +1,337 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"strings"
+)
+
+// seqNode represents a node of the implicit treap backing a Sequence.
+// agg and rev combine the subtree's elements left to right and right to left.
+type seqNode[T any] struct {
+	value    T
+	agg      T
+	rev      T
+	priority uint32
+	size     int
+	reversed bool
+	left     *seqNode[T]
+	right    *seqNode[T]
+}
+
+// Sequence represents a list backed by an implicit treap, where a node's position
+// is the size of everything to its left rather than a stored key.
+// Insertion, deletion, access, splitting, concatenation and reversal of a range
+// all take expected O(log n).
+type Sequence[T any] struct {
+	root     *seqNode[T]
+	combine  func(a, b T) T
+	identity T
+}
+
+// NewSequence creates a new empty Sequence
+func NewSequence[T any]() *Sequence[T] {
+	return &Sequence[T]{}
+}
+
+// NewSequenceFunc creates a new empty Sequence whose Aggregate combines elements
+// with combine, an associative operation with neutral element identity
+func NewSequenceFunc[T any](combine func(a, b T) T, identity T) *Sequence[T] {
+	return &Sequence[T]{
+		combine:  combine,
+		identity: identity,
+	}
+}
+
+// FromSequenceSlice creates a new Sequence from the elements of a slice
+func FromSequenceSlice[T any](slice []T) *Sequence[T] {
+	s := NewSequence[T]()
+	for _, item := range slice {
+		s.Append(item)
+	}
+	return s
+}
+
+// Insert places item at index i, shifting later elements right
+func (s *Sequence[T]) Insert(i int, item T) error {
+	if i < 0 || i > s.Size() {
+		return errors.New("index out of bounds")
+	}
+	left, right := s.split(s.root, i)
+	s.root = s.merge(s.merge(left, s.newNode(item)), right)
+	return nil
+}
+
+// Append adds item to the end of the sequence
+func (s *Sequence[T]) Append(item T) {
+	s.root = s.merge(s.root, s.newNode(item))
+}
+
+// Delete removes and returns the element at index i
+func (s *Sequence[T]) Delete(i int) (T, error) {
+	if i < 0 || i >= s.Size() {
+		return *new(T), errors.New("index out of bounds")
+	}
+	left, rest := s.split(s.root, i)
+	mid, right := s.split(rest, 1)
+	s.root = s.merge(left, right)
+	return mid.value, nil
+}
+
+// DeleteRange removes the elements in the half-open range [l, r)
+func (s *Sequence[T]) DeleteRange(l, r int) error {
+	if l < 0 || r > s.Size() || l > r {
+		return errors.New("index out of bounds")
+	}
+	left, rest := s.split(s.root, l)
+	_, right := s.split(rest, r-l)
+	s.root = s.merge(left, right)
+	return nil
+}
+
+// At returns the element at index i
+func (s *Sequence[T]) At(i int) (T, error) {
+	if i < 0 || i >= s.Size() {
+		return *new(T), errors.New("index out of bounds")
+	}
+	return s.find(i).value, nil
+}
+
+// Set replaces the element at index i
+func (s *Sequence[T]) Set(i int, item T) error {
+	if i < 0 || i >= s.Size() {
+		return errors.New("index out of bounds")
+	}
+	left, rest := s.split(s.root, i)
+	mid, right := s.split(rest, 1)
+	mid.value = item
+	s.update(mid)
+	s.root = s.merge(s.merge(left, mid), right)
+	return nil
+}
+
+// Split keeps the first i elements in s and returns a new Sequence holding the rest
+func (s *Sequence[T]) Split(i int) (*Sequence[T], error) {
+	if i < 0 || i > s.Size() {
+		return nil, errors.New("index out of bounds")
+	}
+	rest := &Sequence[T]{combine: s.combine, identity: s.identity}
+	s.root, rest.root = s.split(s.root, i)
+	return rest, nil
+}
+
+// Merge moves every element of other to the end of s, leaving other empty.
+// Aggregates stay correct only if both sequences use the same combine function.
+func (s *Sequence[T]) Merge(other *Sequence[T]) {
+	if other == s {
+		return
+	}
+	s.root = s.merge(s.root, other.root)
+	other.root = nil
+}
+
+// Reverse reverses the order of the elements in the half-open range [l, r)
+func (s *Sequence[T]) Reverse(l, r int) error {
+	if l < 0 || r > s.Size() || l > r {
+		return errors.New("index out of bounds")
+	}
+	left, rest := s.split(s.root, l)
+	mid, right := s.split(rest, r-l)
+	mid.toggle()
+	s.root = s.merge(s.merge(left, mid), right)
+	return nil
+}
+
+// Aggregate combines the elements in the half-open range [l, r) in order.
+// It returns an error if the sequence was created without a combine function.
+func (s *Sequence[T]) Aggregate(l, r int) (T, error) {
+	if s.combine == nil {
+		return *new(T), errors.New("sequence has no combine function")
+	}
+	if l < 0 || r > s.Size() || l > r {
+		return *new(T), errors.New("index out of bounds")
+	}
+	left, rest := s.split(s.root, l)
+	mid, right := s.split(rest, r-l)
+	result := s.aggregate(mid)
+	s.root = s.merge(s.merge(left, mid), right)
+	return result, nil
+}
+
+// All returns an iterator over the elements in order
+func (s *Sequence[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.root.ascend(yield)
+	}
+}
+
+// Size returns the number of elements
+func (s *Sequence[T]) Size() int {
+	return s.root.getSize()
+}
+
+// IsEmpty returns true if the sequence has no elements
+func (s *Sequence[T]) IsEmpty() bool {
+	return s.root == nil
+}
+
+// Clear removes all elements from the sequence
+func (s *Sequence[T]) Clear() {
+	s.root = nil
+}
+
+// ToSlice returns the elements in order
+func (s *Sequence[T]) ToSlice() []T {
+	result := make([]T, 0, s.Size())
+	for item := range s.All() {
+		result = append(result, item)
+	}
+	return result
+}
+
+// String returns a string representation of the sequence
+func (s *Sequence[T]) String() string {
+	if s.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for item := range s.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v", item))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// newNode creates a single-element treap with a random priority
+func (s *Sequence[T]) newNode(item T) *seqNode[T] {
+	return &seqNode[T]{value: item, agg: item, rev: item, priority: rand.Uint32(), size: 1}
+}
+
+// split divides the treap rooted at n into its first k elements and the rest
+func (s *Sequence[T]) split(n *seqNode[T], k int) (*seqNode[T], *seqNode[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	n.push()
+	if n.left.getSize() >= k {
+		left, right := s.split(n.left, k)
+		n.left = right
+		s.update(n)
+		return left, n
+	}
+	left, right := s.split(n.right, k-n.left.getSize()-1)
+	n.right = left
+	s.update(n)
+	return n, right
+}
+
+// merge concatenates the treaps rooted at a and b, keeping the higher priority on top
+func (s *Sequence[T]) merge(a, b *seqNode[T]) *seqNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		a.push()
+		a.right = s.merge(a.right, b)
+		s.update(a)
+		return a
+	}
+	b.push()
+	b.left = s.merge(a, b.left)
+	s.update(b)
+	return b
+}
+
+// find returns the node at index i, pushing pending reversals on the way down
+func (s *Sequence[T]) find(i int) *seqNode[T] {
+	n := s.root
+	for {
+		n.push()
+		leftSize := n.left.getSize()
+		switch {
+		case i < leftSize:
+			n = n.left
+		case i == leftSize:
+			return n
+		default:
+			i -= leftSize + 1
+			n = n.right
+		}
+	}
+}
+
+// update recomputes the size and aggregates of n from its children
+func (s *Sequence[T]) update(n *seqNode[T]) {
+	n.size = 1 + n.left.getSize() + n.right.getSize()
+	if s.combine == nil {
+		return
+	}
+	n.agg = s.combine(s.combine(s.aggregate(n.left), n.value), s.aggregate(n.right))
+	n.rev = s.combine(s.combine(s.reverseAggregate(n.right), n.value), s.reverseAggregate(n.left))
+}
+
+// aggregate returns the left-to-right combination of the subtree, treating nil as the identity
+func (s *Sequence[T]) aggregate(n *seqNode[T]) T {
+	if n == nil {
+		return s.identity
+	}
+	return n.agg
+}
+
+// reverseAggregate returns the right-to-left combination of the subtree, treating nil as the identity
+func (s *Sequence[T]) reverseAggregate(n *seqNode[T]) T {
+	if n == nil {
+		return s.identity
+	}
+	return n.rev
+}
+
+// toggle reverses the subtree rooted at n, deferring the work below n
+func (n *seqNode[T]) toggle() {
+	if n == nil {
+		return
+	}
+	n.left, n.right = n.right, n.left
+	n.agg, n.rev = n.rev, n.agg
+	n.reversed = !n.reversed
+}
+
+// push hands a pending reversal of n down to its children
+func (n *seqNode[T]) push() {
+	if n.reversed {
+		n.left.toggle()
+		n.right.toggle()
+		n.reversed = false
+	}
+}
+
+// ascend yields the elements of the subtree in order
+func (n *seqNode[T]) ascend(yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	n.push()
+	return n.left.ascend(yield) && yield(n.value) && n.right.ascend(yield)
+}
+
+// getSize returns the number of elements in the subtree, treating nil as empty
+func (n *seqNode[T]) getSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
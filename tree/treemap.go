@@ -0,0 +1,384 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// mapNode represents a node of the AVL tree backing a TreeMap
+type mapNode[K any, V any] struct {
+	key    K
+	value  V
+	left   *mapNode[K, V]
+	right  *mapNode[K, V]
+	height int
+}
+
+// TreeMap represents a sorted map backed by an AVL tree.
+// Lookups, insertions and deletions are O(log n).
+type TreeMap[K any, V any] struct {
+	root    *mapNode[K, V]
+	size    int
+	compare func(a, b K) int
+}
+
+// NewTreeMap creates a new empty TreeMap ordered by the natural order of K
+func NewTreeMap[K constraints.Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMapFunc[K, V](cmp.Compare[K])
+}
+
+// NewTreeMapFunc creates a new empty TreeMap ordered by compare, which
+// returns a negative number, zero or a positive number when a < b, a == b or a > b
+func NewTreeMapFunc[K any, V any](compare func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{
+		compare: compare,
+	}
+}
+
+// Put associates value with key, replacing any previous value
+func (m *TreeMap[K, V]) Put(key K, value V) {
+	var added bool
+	m.root, added = m.put(m.root, key, value)
+	if added {
+		m.size++
+	}
+}
+
+// Get returns the value associated with key
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	n := m.root
+	for n != nil {
+		c := m.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	return *new(V), false
+}
+
+// Contains returns true if key is in the map
+func (m *TreeMap[K, V]) Contains(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Delete removes key from the map and returns true if it was present
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	var removed bool
+	m.root, removed = m.delete(m.root, key)
+	if removed {
+		m.size--
+	}
+	return removed
+}
+
+// Min returns the smallest key and its value
+func (m *TreeMap[K, V]) Min() (K, V, bool) {
+	if m.root == nil {
+		return *new(K), *new(V), false
+	}
+	n := m.root.min()
+	return n.key, n.value, true
+}
+
+// Max returns the largest key and its value
+func (m *TreeMap[K, V]) Max() (K, V, bool) {
+	if m.root == nil {
+		return *new(K), *new(V), false
+	}
+	n := m.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, true
+}
+
+// Floor returns the largest key less than or equal to key
+func (m *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	return m.search(key, true, true).found()
+}
+
+// Ceiling returns the smallest key greater than or equal to key
+func (m *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return m.search(key, false, true).found()
+}
+
+// Lower returns the largest key strictly less than key
+func (m *TreeMap[K, V]) Lower(key K) (K, V, bool) {
+	return m.search(key, true, false).found()
+}
+
+// Higher returns the smallest key strictly greater than key
+func (m *TreeMap[K, V]) Higher(key K) (K, V, bool) {
+	return m.search(key, false, false).found()
+}
+
+// Range returns an iterator over the entries with lo <= key <= hi in ascending order
+func (m *TreeMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.ascendRange(m.root, lo, hi, yield)
+	}
+}
+
+// All returns an iterator over all entries in ascending key order
+func (m *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.ascend(yield)
+	}
+}
+
+// Backward returns an iterator over all entries in descending key order
+func (m *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.descend(yield)
+	}
+}
+
+// Keys returns an iterator over all keys in ascending order
+func (m *TreeMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over all values in ascending key order
+func (m *TreeMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the number of entries in the map
+func (m *TreeMap[K, V]) Size() int {
+	return m.size
+}
+
+// IsEmpty returns true if the map has no entries
+func (m *TreeMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Clear removes all entries from the map
+func (m *TreeMap[K, V]) Clear() {
+	m.root = nil
+	m.size = 0
+}
+
+// String returns a string representation of the map in ascending key order
+func (m *TreeMap[K, V]) String() string {
+	if m.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for k, v := range m.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v:%v", k, v))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// put inserts key into the subtree rooted at n and returns the new root
+func (m *TreeMap[K, V]) put(n *mapNode[K, V], key K, value V) (*mapNode[K, V], bool) {
+	if n == nil {
+		return &mapNode[K, V]{key: key, value: value, height: 1}, true
+	}
+
+	var added bool
+	c := m.compare(key, n.key)
+	switch {
+	case c < 0:
+		n.left, added = m.put(n.left, key, value)
+	case c > 0:
+		n.right, added = m.put(n.right, key, value)
+	default:
+		n.value = value
+		return n, false
+	}
+	return n.rebalance(), added
+}
+
+// delete removes key from the subtree rooted at n and returns the new root
+func (m *TreeMap[K, V]) delete(n *mapNode[K, V], key K) (*mapNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var removed bool
+	c := m.compare(key, n.key)
+	switch {
+	case c < 0:
+		n.left, removed = m.delete(n.left, key)
+	case c > 0:
+		n.right, removed = m.delete(n.right, key)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		// Replace with the in-order successor
+		successor := n.right.min()
+		n.key, n.value = successor.key, successor.value
+		n.right, _ = m.delete(n.right, successor.key)
+		removed = true
+	}
+	return n.rebalance(), removed
+}
+
+// search finds the closest key below (or above) key, optionally including key itself
+func (m *TreeMap[K, V]) search(key K, below, inclusive bool) *mapNode[K, V] {
+	var best *mapNode[K, V]
+	n := m.root
+	for n != nil {
+		c := m.compare(key, n.key)
+		if c == 0 && inclusive {
+			return n
+		}
+		if below {
+			if c > 0 {
+				best = n
+				n = n.right
+			} else {
+				n = n.left
+			}
+		} else {
+			if c < 0 {
+				best = n
+				n = n.left
+			} else {
+				n = n.right
+			}
+		}
+	}
+	return best
+}
+
+// ascendRange yields the entries of n within [lo, hi], pruning subtrees outside it
+func (m *TreeMap[K, V]) ascendRange(n *mapNode[K, V], lo, hi K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := m.compare(n.key, lo) >= 0
+	belowHi := m.compare(n.key, hi) <= 0
+	if aboveLo && !m.ascendRange(n.left, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+	if belowHi {
+		return m.ascendRange(n.right, lo, hi, yield)
+	}
+	return true
+}
+
+// found unpacks an optional node into a key, value and presence flag
+func (n *mapNode[K, V]) found() (K, V, bool) {
+	if n == nil {
+		return *new(K), *new(V), false
+	}
+	return n.key, n.value, true
+}
+
+// ascend yields the entries of the subtree in ascending order
+func (n *mapNode[K, V]) ascend(yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.left.ascend(yield) && yield(n.key, n.value) && n.right.ascend(yield)
+}
+
+// descend yields the entries of the subtree in descending order
+func (n *mapNode[K, V]) descend(yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.right.descend(yield) && yield(n.key, n.value) && n.left.descend(yield)
+}
+
+// min returns the leftmost node of the subtree rooted at n
+func (n *mapNode[K, V]) min() *mapNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// getHeight returns the height of the subtree, treating nil as 0
+func (n *mapNode[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the height of n from its children
+func (n *mapNode[K, V]) update() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+}
+
+// rotateLeft rotates n left and returns the new subtree root
+func (n *mapNode[K, V]) rotateLeft() *mapNode[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+// rotateRight rotates n right and returns the new subtree root
+func (n *mapNode[K, V]) rotateRight() *mapNode[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+// rebalance restores the AVL property at n and returns the new subtree root
+func (n *mapNode[K, V]) rebalance() *mapNode[K, V] {
+	n.update()
+	balance := n.left.getHeight() - n.right.getHeight()
+	if balance > 1 {
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	}
+	if balance < -1 {
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
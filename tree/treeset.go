@@ -0,0 +1,164 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// TreeSet represents a sorted set backed by a TreeMap
+type TreeSet[K any] struct {
+	m *TreeMap[K, struct{}]
+}
+
+// NewTreeSet creates a new empty TreeSet ordered by the natural order of K
+func NewTreeSet[K constraints.Ordered]() *TreeSet[K] {
+	return NewTreeSetFunc(cmp.Compare[K])
+}
+
+// NewTreeSetFunc creates a new empty TreeSet ordered by compare
+func NewTreeSetFunc[K any](compare func(a, b K) int) *TreeSet[K] {
+	return &TreeSet[K]{
+		m: NewTreeMapFunc[K, struct{}](compare),
+	}
+}
+
+// FromTreeSetSlice creates a new TreeSet from the elements of a slice
+func FromTreeSetSlice[K constraints.Ordered](slice []K) *TreeSet[K] {
+	set := NewTreeSet[K]()
+	for _, item := range slice {
+		set.Add(item)
+	}
+	return set
+}
+
+// Add inserts item and returns true if it was not already present
+func (s *TreeSet[K]) Add(item K) bool {
+	if s.m.Contains(item) {
+		return false
+	}
+	s.m.Put(item, struct{}{})
+	return true
+}
+
+// Remove deletes item and returns true if it was present
+func (s *TreeSet[K]) Remove(item K) bool {
+	return s.m.Delete(item)
+}
+
+// Contains returns true if item is in the set
+func (s *TreeSet[K]) Contains(item K) bool {
+	return s.m.Contains(item)
+}
+
+// Min returns the smallest element
+func (s *TreeSet[K]) Min() (K, bool) {
+	k, _, ok := s.m.Min()
+	return k, ok
+}
+
+// Max returns the largest element
+func (s *TreeSet[K]) Max() (K, bool) {
+	k, _, ok := s.m.Max()
+	return k, ok
+}
+
+// Floor returns the largest element less than or equal to item
+func (s *TreeSet[K]) Floor(item K) (K, bool) {
+	k, _, ok := s.m.Floor(item)
+	return k, ok
+}
+
+// Ceiling returns the smallest element greater than or equal to item
+func (s *TreeSet[K]) Ceiling(item K) (K, bool) {
+	k, _, ok := s.m.Ceiling(item)
+	return k, ok
+}
+
+// Lower returns the largest element strictly less than item
+func (s *TreeSet[K]) Lower(item K) (K, bool) {
+	k, _, ok := s.m.Lower(item)
+	return k, ok
+}
+
+// Higher returns the smallest element strictly greater than item
+func (s *TreeSet[K]) Higher(item K) (K, bool) {
+	k, _, ok := s.m.Higher(item)
+	return k, ok
+}
+
+// Range returns an iterator over the elements with lo <= item <= hi in ascending order
+func (s *TreeSet[K]) Range(lo, hi K) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range s.m.Range(lo, hi) {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over all elements in ascending order
+func (s *TreeSet[K]) All() iter.Seq[K] {
+	return s.m.Keys()
+}
+
+// Backward returns an iterator over all elements in descending order
+func (s *TreeSet[K]) Backward() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range s.m.Backward() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the number of elements in the set
+func (s *TreeSet[K]) Size() int {
+	return s.m.Size()
+}
+
+// IsEmpty returns true if the set has no elements
+func (s *TreeSet[K]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+// Clear removes all elements from the set
+func (s *TreeSet[K]) Clear() {
+	s.m.Clear()
+}
+
+// ToSlice returns the elements in ascending order
+func (s *TreeSet[K]) ToSlice() []K {
+	result := make([]K, 0, s.m.Size())
+	for k := range s.m.Keys() {
+		result = append(result, k)
+	}
+	return result
+}
+
+// String returns a string representation of the set in ascending order
+func (s *TreeSet[K]) String() string {
+	if s.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for k := range s.m.Keys() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v", k))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
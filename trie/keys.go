@@ -0,0 +1,101 @@
+package trie
+
+import "slices"
+
+// Wildcards understood by Match
+const (
+	// AnyUnit matches exactly one byte (or rune, for rune-keyed trees)
+	AnyUnit = '?'
+	// AnyRun matches any sequence of bytes (or runes), including an empty one
+	AnyRun = '*'
+)
+
+// split breaks key into the units a tree branches on: runes if runes is true, bytes otherwise.
+// Bytes are widened to runes so both modes share one node layout.
+func split(key string, runes bool) []rune {
+	if runes {
+		return []rune(key)
+	}
+	units := make([]rune, len(key))
+	for i := range len(key) {
+		units[i] = rune(key[i])
+	}
+	return units
+}
+
+// join is the inverse of split
+func join(units []rune, runes bool) string {
+	if runes {
+		return string(units)
+	}
+	b := make([]byte, len(units))
+	for i, u := range units {
+		b[i] = byte(u)
+	}
+	return string(b)
+}
+
+// commonPrefixLen returns the length of the longest common prefix of a and b
+func commonPrefixLen(a, b []rune) int {
+	n := min(len(a), len(b))
+	for i := range n {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
+
+// matcher simulates a wildcard pattern as a set of active positions, so a
+// tree can be matched in one pass without visiting any node twice
+type matcher struct {
+	pattern []rune
+}
+
+// start returns the positions active before any unit is consumed
+func (m matcher) start() []bool {
+	states := make([]bool, len(m.pattern)+1)
+	states[0] = true
+	m.closure(states)
+	return states
+}
+
+// step consumes one unit and returns the next positions and whether any is active
+func (m matcher) step(states []bool, unit rune) ([]bool, bool) {
+	next := make([]bool, len(states))
+	alive := false
+	for i, on := range states {
+		if !on || i == len(m.pattern) {
+			continue
+		}
+		switch p := m.pattern[i]; {
+		case p == AnyRun:
+			next[i] = true
+			alive = true
+		case p == AnyUnit || p == unit:
+			next[i+1] = true
+			alive = true
+		}
+	}
+	m.closure(next)
+	return next, alive
+}
+
+// accepts returns true if the whole pattern has been matched
+func (m matcher) accepts(states []bool) bool {
+	return states[len(m.pattern)]
+}
+
+// closure lets each AnyRun also match the empty sequence
+func (m matcher) closure(states []bool) {
+	for i := range m.pattern {
+		if states[i] && m.pattern[i] == AnyRun {
+			states[i+1] = true
+		}
+	}
+}
+
+// childIndex finds the child labelled unit in a sorted label list
+func childIndex(labels []rune, unit rune) (int, bool) {
+	return slices.BinarySearch(labels, unit)
+}
@@ -0,0 +1,328 @@
+package trie
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// radixNode represents a node of a RadixTree. Each node stores the run of
+// units on the edge leading to it, and children are sorted by their first unit.
+type radixNode[V any] struct {
+	prefix   []rune
+	labels   []rune
+	children []*radixNode[V]
+	value    V
+	hasValue bool
+}
+
+// RadixTree represents a compressed prefix tree mapping string keys to values.
+// Chains of single-child nodes are collapsed into one edge, so it uses far
+// fewer nodes than a Trie while offering the same operations.
+type RadixTree[V any] struct {
+	root  *radixNode[V]
+	size  int
+	runes bool
+}
+
+// NewRadixTree creates a new empty radix tree keyed by bytes
+func NewRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{root: &radixNode[V]{}}
+}
+
+// NewRuneRadixTree creates a new empty radix tree keyed by runes
+func NewRuneRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{root: &radixNode[V]{}, runes: true}
+}
+
+// Insert associates value with key and returns true if key was not already present
+func (t *RadixTree[V]) Insert(key string, value V) bool {
+	n := t.root
+	units := split(key, t.runes)
+
+	for len(units) > 0 {
+		i, ok := childIndex(n.labels, units[0])
+		if !ok {
+			n.labels = slices.Insert(n.labels, i, units[0])
+			n.children = slices.Insert(n.children, i, &radixNode[V]{
+				prefix:   units,
+				value:    value,
+				hasValue: true,
+			})
+			t.size++
+			return true
+		}
+
+		c := n.children[i]
+		common := commonPrefixLen(c.prefix, units)
+		if common < len(c.prefix) {
+			// Split the edge where the key diverges from it
+			mid := &radixNode[V]{prefix: slices.Clone(c.prefix[:common])}
+			c.prefix = slices.Clone(c.prefix[common:])
+			mid.labels = []rune{c.prefix[0]}
+			mid.children = []*radixNode[V]{c}
+			n.children[i] = mid
+			c = mid
+		}
+		n = c
+		units = units[common:]
+	}
+
+	added := !n.hasValue
+	if added {
+		t.size++
+	}
+	n.value = value
+	n.hasValue = true
+	return added
+}
+
+// Get returns the value associated with key
+func (t *RadixTree[V]) Get(key string) (V, bool) {
+	n := t.root
+	units := split(key, t.runes)
+	for len(units) > 0 {
+		c := n.child(units[0])
+		if c == nil || commonPrefixLen(c.prefix, units) < len(c.prefix) {
+			return *new(V), false
+		}
+		n = c
+		units = units[len(c.prefix):]
+	}
+	if !n.hasValue {
+		return *new(V), false
+	}
+	return n.value, true
+}
+
+// Contains returns true if key is in the tree
+func (t *RadixTree[V]) Contains(key string) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Delete removes key and returns true if it was present.
+// Edges are merged again where the removal leaves a single child.
+func (t *RadixTree[V]) Delete(key string) bool {
+	var parent *radixNode[V]
+	n := t.root
+	units := split(key, t.runes)
+	for len(units) > 0 {
+		c := n.child(units[0])
+		if c == nil || commonPrefixLen(c.prefix, units) < len(c.prefix) {
+			return false
+		}
+		parent, n = n, c
+		units = units[len(c.prefix):]
+	}
+	if !n.hasValue {
+		return false
+	}
+
+	n.value = *new(V)
+	n.hasValue = false
+	t.size--
+
+	if n == t.root {
+		return true
+	}
+	switch len(n.children) {
+	case 0:
+		parent.removeChild(n.prefix[0])
+		if parent != t.root && !parent.hasValue && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		n.mergeChild()
+	}
+	return true
+}
+
+// HasPrefix returns true if any key starts with prefix
+func (t *RadixTree[V]) HasPrefix(prefix string) bool {
+	n, _ := t.locate(split(prefix, t.runes))
+	return n != nil && (n.hasValue || len(n.children) > 0)
+}
+
+// KeysWithPrefix returns an iterator over the keys starting with prefix in lexicographic order
+func (t *RadixTree[V]) KeysWithPrefix(prefix string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if n, path := t.locate(split(prefix, t.runes)); n != nil {
+			t.walk(n, path, func(key string, _ V) bool { return yield(key) })
+		}
+	}
+}
+
+// LongestPrefixOf returns the longest key that is a prefix of s, with its value
+func (t *RadixTree[V]) LongestPrefixOf(s string) (string, V, bool) {
+	units := split(s, t.runes)
+	best, bestLen := t.root, -1
+	if t.root.hasValue {
+		bestLen = 0
+	}
+
+	n, consumed := t.root, 0
+	for consumed < len(units) {
+		c := n.child(units[consumed])
+		if c == nil || commonPrefixLen(c.prefix, units[consumed:]) < len(c.prefix) {
+			break
+		}
+		n = c
+		consumed += len(c.prefix)
+		if n.hasValue {
+			best, bestLen = n, consumed
+		}
+	}
+
+	if bestLen < 0 {
+		return "", *new(V), false
+	}
+	return join(units[:bestLen], t.runes), best.value, true
+}
+
+// Match returns an iterator over the keys matching pattern in lexicographic order.
+// AnyUnit ('?') matches exactly one byte or rune and AnyRun ('*') matches any sequence.
+func (t *RadixTree[V]) Match(pattern string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		m := matcher{pattern: split(pattern, t.runes)}
+		t.match(t.root, m, m.start(), nil, yield)
+	}
+}
+
+// All returns an iterator over all entries in lexicographic key order
+func (t *RadixTree[V]) All() iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		t.walk(t.root, nil, yield)
+	}
+}
+
+// Keys returns all keys in lexicographic order
+func (t *RadixTree[V]) Keys() []string {
+	keys := make([]string, 0, t.size)
+	for k := range t.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Size returns the number of keys in the tree
+func (t *RadixTree[V]) Size() int {
+	return t.size
+}
+
+// IsEmpty returns true if the tree has no keys
+func (t *RadixTree[V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes all keys from the tree
+func (t *RadixTree[V]) Clear() {
+	t.root = &radixNode[V]{}
+	t.size = 0
+}
+
+// String returns a string representation of the tree in lexicographic key order
+func (t *RadixTree[V]) String() string {
+	if t.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for k, v := range t.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%s:%v", k, v))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// locate finds the shallowest node whose key starts with units, returning
+// the node and its full key; it returns nil if no key starts with units
+func (t *RadixTree[V]) locate(units []rune) (*radixNode[V], []rune) {
+	n := t.root
+	var path []rune
+	for len(units) > 0 {
+		c := n.child(units[0])
+		if c == nil {
+			return nil, nil
+		}
+		common := commonPrefixLen(c.prefix, units)
+		if common == len(units) {
+			// The prefix ends partway along (or exactly at the end of) this edge
+			return c, append(path, c.prefix...)
+		}
+		if common < len(c.prefix) {
+			return nil, nil
+		}
+		path = append(path, c.prefix...)
+		n = c
+		units = units[common:]
+	}
+	return n, path
+}
+
+// walk yields every entry at or below n, where path spells the key of n
+func (t *RadixTree[V]) walk(n *radixNode[V], path []rune, yield func(string, V) bool) bool {
+	if n.hasValue && !yield(join(path, t.runes), n.value) {
+		return false
+	}
+	for _, c := range n.children {
+		if !t.walk(c, append(path, c.prefix...), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// match yields the keys at or below n accepted by m, pruning branches with no live states
+func (t *RadixTree[V]) match(n *radixNode[V], m matcher, states []bool, path []rune, yield func(string) bool) bool {
+	if n.hasValue && m.accepts(states) && !yield(join(path, t.runes)) {
+		return false
+	}
+	for _, c := range n.children {
+		next, alive := states, true
+		for _, u := range c.prefix {
+			if next, alive = m.step(next, u); !alive {
+				break
+			}
+		}
+		if alive && !t.match(c, m, next, append(path, c.prefix...), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// child returns the child whose edge starts with u, or nil
+func (n *radixNode[V]) child(u rune) *radixNode[V] {
+	if i, ok := childIndex(n.labels, u); ok {
+		return n.children[i]
+	}
+	return nil
+}
+
+// removeChild drops the child whose edge starts with u
+func (n *radixNode[V]) removeChild(u rune) {
+	if i, ok := childIndex(n.labels, u); ok {
+		n.labels = slices.Delete(n.labels, i, i+1)
+		n.children = slices.Delete(n.children, i, i+1)
+	}
+}
+
+// mergeChild absorbs the only child of a node that holds no value
+func (n *radixNode[V]) mergeChild() {
+	c := n.children[0]
+	n.prefix = slices.Concat(n.prefix, c.prefix)
+	n.labels = c.labels
+	n.children = c.children
+	n.value = c.value
+	n.hasValue = c.hasValue
+}
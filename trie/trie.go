@@ -0,0 +1,267 @@
+package trie
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// trieNode represents a node of a Trie. Children are kept sorted by label
+// so iteration is in lexicographic key order.
+type trieNode[V any] struct {
+	labels   []rune
+	children []*trieNode[V]
+	value    V
+	hasValue bool
+}
+
+// Trie represents a prefix tree mapping string keys to values.
+// A byte trie branches on each byte of the key; a rune trie branches on each
+// rune, which keeps multi-byte characters together for wildcard matching.
+type Trie[V any] struct {
+	root  *trieNode[V]
+	size  int
+	runes bool
+}
+
+// NewTrie creates a new empty trie keyed by bytes
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{root: &trieNode[V]{}}
+}
+
+// NewRuneTrie creates a new empty trie keyed by runes
+func NewRuneTrie[V any]() *Trie[V] {
+	return &Trie[V]{root: &trieNode[V]{}, runes: true}
+}
+
+// Insert associates value with key and returns true if key was not already present
+func (t *Trie[V]) Insert(key string, value V) bool {
+	n := t.root
+	for _, u := range split(key, t.runes) {
+		n = n.addChild(u)
+	}
+
+	added := !n.hasValue
+	if added {
+		t.size++
+	}
+	n.value = value
+	n.hasValue = true
+	return added
+}
+
+// Get returns the value associated with key
+func (t *Trie[V]) Get(key string) (V, bool) {
+	n := t.find(split(key, t.runes))
+	if n == nil || !n.hasValue {
+		return *new(V), false
+	}
+	return n.value, true
+}
+
+// Contains returns true if key is in the trie
+func (t *Trie[V]) Contains(key string) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Delete removes key and returns true if it was present.
+// Nodes left without keys below them are pruned.
+func (t *Trie[V]) Delete(key string) bool {
+	units := split(key, t.runes)
+	path := make([]*trieNode[V], 0, len(units)+1)
+	n := t.root
+	path = append(path, n)
+	for _, u := range units {
+		if n = n.child(u); n == nil {
+			return false
+		}
+		path = append(path, n)
+	}
+	if !n.hasValue {
+		return false
+	}
+
+	n.value = *new(V)
+	n.hasValue = false
+	t.size--
+
+	for i := len(units); i > 0; i-- {
+		node := path[i]
+		if node.hasValue || len(node.children) > 0 {
+			break
+		}
+		path[i-1].removeChild(units[i-1])
+	}
+	return true
+}
+
+// HasPrefix returns true if any key starts with prefix
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	n := t.find(split(prefix, t.runes))
+	return n != nil && (n.hasValue || len(n.children) > 0)
+}
+
+// KeysWithPrefix returns an iterator over the keys starting with prefix in lexicographic order
+func (t *Trie[V]) KeysWithPrefix(prefix string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		units := split(prefix, t.runes)
+		if n := t.find(units); n != nil {
+			t.walk(n, units, func(key string, _ V) bool { return yield(key) })
+		}
+	}
+}
+
+// LongestPrefixOf returns the longest key that is a prefix of s, with its value
+func (t *Trie[V]) LongestPrefixOf(s string) (string, V, bool) {
+	units := split(s, t.runes)
+	best, bestLen := t.root, -1
+	if t.root.hasValue {
+		bestLen = 0
+	}
+
+	n := t.root
+	for i, u := range units {
+		if n = n.child(u); n == nil {
+			break
+		}
+		if n.hasValue {
+			best, bestLen = n, i+1
+		}
+	}
+
+	if bestLen < 0 {
+		return "", *new(V), false
+	}
+	return join(units[:bestLen], t.runes), best.value, true
+}
+
+// Match returns an iterator over the keys matching pattern in lexicographic order.
+// AnyUnit ('?') matches exactly one byte or rune and AnyRun ('*') matches any sequence.
+func (t *Trie[V]) Match(pattern string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		m := matcher{pattern: split(pattern, t.runes)}
+		t.match(t.root, m, m.start(), nil, yield)
+	}
+}
+
+// All returns an iterator over all entries in lexicographic key order
+func (t *Trie[V]) All() iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		t.walk(t.root, nil, yield)
+	}
+}
+
+// Keys returns all keys in lexicographic order
+func (t *Trie[V]) Keys() []string {
+	keys := make([]string, 0, t.size)
+	for k := range t.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Size returns the number of keys in the trie
+func (t *Trie[V]) Size() int {
+	return t.size
+}
+
+// IsEmpty returns true if the trie has no keys
+func (t *Trie[V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes all keys from the trie
+func (t *Trie[V]) Clear() {
+	t.root = &trieNode[V]{}
+	t.size = 0
+}
+
+// String returns a string representation of the trie in lexicographic key order
+func (t *Trie[V]) String() string {
+	if t.IsEmpty() {
+		return "[]"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	first := true
+	for k, v := range t.All() {
+		if !first {
+			sb.WriteString(" ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%s:%v", k, v))
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// find returns the node reached by following units from the root, or nil
+func (t *Trie[V]) find(units []rune) *trieNode[V] {
+	n := t.root
+	for _, u := range units {
+		if n = n.child(u); n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// walk yields every entry at or below n, where path spells the key of n
+func (t *Trie[V]) walk(n *trieNode[V], path []rune, yield func(string, V) bool) bool {
+	if n.hasValue && !yield(join(path, t.runes), n.value) {
+		return false
+	}
+	for i, c := range n.children {
+		if !t.walk(c, append(path, n.labels[i]), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// match yields the keys at or below n accepted by m, pruning branches with no live states
+func (t *Trie[V]) match(n *trieNode[V], m matcher, states []bool, path []rune, yield func(string) bool) bool {
+	if n.hasValue && m.accepts(states) && !yield(join(path, t.runes)) {
+		return false
+	}
+	for i, c := range n.children {
+		next, alive := m.step(states, n.labels[i])
+		if alive && !t.match(c, m, next, append(path, n.labels[i]), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// child returns the child labelled u, or nil
+func (n *trieNode[V]) child(u rune) *trieNode[V] {
+	if i, ok := childIndex(n.labels, u); ok {
+		return n.children[i]
+	}
+	return nil
+}
+
+// addChild returns the child labelled u, creating it if needed
+func (n *trieNode[V]) addChild(u rune) *trieNode[V] {
+	i, ok := childIndex(n.labels, u)
+	if ok {
+		return n.children[i]
+	}
+	c := &trieNode[V]{}
+	n.labels = slices.Insert(n.labels, i, u)
+	n.children = slices.Insert(n.children, i, c)
+	return c
+}
+
+// removeChild drops the child labelled u
+func (n *trieNode[V]) removeChild(u rune) {
+	if i, ok := childIndex(n.labels, u); ok {
+		n.labels = slices.Delete(n.labels, i, i+1)
+		n.children = slices.Delete(n.children, i, i+1)
+	}
+}